@@ -6,7 +6,6 @@ import (
 
 	"github.com/bartosian/suimon/internal/core/controllers"
 	"github.com/bartosian/suimon/internal/core/controllers/monitor"
-	domainconfig "github.com/bartosian/suimon/internal/core/domain/config"
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
 	"github.com/bartosian/suimon/internal/core/handlers/commands"
 )
@@ -16,18 +15,11 @@ func main() {
 
 	defer handlePanic(cliGateway)
 
-	config, err := domainconfig.NewConfig()
-	if err != nil {
-		// If an error occurs during initialization of the tables object, log the error and exit the program.
-		cliGateway.Error(err.Error())
-
-		return
-	}
-
 	// Instantiate controllers
 	rootController := controllers.NewRootController(cliGateway)
 	versionController := controllers.NewVersionController(cliGateway)
-	monitorController := monitor.NewController(config, cliGateway)
+	configController := controllers.NewConfigController(cliGateway)
+	monitorController := monitor.NewController(cliGateway)
 
 	// Instantiate Handlers - Root
 	rootCmdHandler := cmdhandlers.NewRootHandler(rootController)
@@ -35,9 +27,23 @@ func main() {
 	// Instantiate Handlers - second level
 	versionCmdHandler := cmdhandlers.NewVersionHandler(versionController)
 	monitorCmdHandler := cmdhandlers.NewMonitorHandler(monitorController)
+	exporterCmdHandler := cmdhandlers.NewExporterHandler(monitorController)
+	watchCmdHandler := cmdhandlers.NewWatchHandler(monitorController)
+	historyCmdHandler := cmdhandlers.NewHistoryHandler(monitorController)
+	configCmdHandler := cmdhandlers.NewConfigHandler()
+	validatorsCmdHandler := cmdhandlers.NewValidatorsHandler()
+
+	// Instantiate Handlers - third level
+	configInitCmdHandler := cmdhandlers.NewConfigInitHandler(configController)
+	configLintCmdHandler := cmdhandlers.NewConfigLintHandler(configController)
+	validatorsDiffCmdHandler := cmdhandlers.NewValidatorsDiffHandler(monitorController)
+
+	// Add subcommands to the second level command handlers
+	configCmdHandler.AddSubCommands(configInitCmdHandler, configLintCmdHandler)
+	validatorsCmdHandler.AddSubCommands(validatorsDiffCmdHandler)
 
 	// Add subcommands to the root command handler
-	rootCmdHandler.AddSubCommands(versionCmdHandler, monitorCmdHandler)
+	rootCmdHandler.AddSubCommands(versionCmdHandler, monitorCmdHandler, exporterCmdHandler, watchCmdHandler, historyCmdHandler, validatorsCmdHandler, configCmdHandler)
 
 	// Start the root command handler
 	rootCmdHandler.Start()
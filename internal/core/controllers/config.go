@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/service/alerter"
+	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/gateways/notifiergw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type ConfigController struct {
+	cliGateway *cligw.Gateway
+}
+
+func NewConfigController(
+	cliGateway *cligw.Gateway,
+) ports.ConfigController {
+	return &ConfigController{
+		cliGateway: cliGateway,
+	}
+}
+
+// Lint checks the configuration files and reports all problems found in them with the file names and line numbers.
+// Along with the hosts entries, the alert rules and the notifiers are checked the same way they are parsed by the watch command,
+// the dashboards layouts are checked the same way they are parsed by the dynamic monitor.
+func (c *ConfigController) Lint(options ports.ConfigLintOptions) error {
+	files := options.Files
+
+	if len(files) == 0 {
+		dirPath, err := config.DirPath()
+		if err != nil {
+			return err
+		}
+
+		if files, err = config.Files(dirPath); err != nil {
+			return err
+		}
+	}
+
+	var problemsCount int
+
+	for _, file := range files {
+		cfg, err := config.ReadConfigFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read configuration file: %w", err)
+		}
+
+		problems := append(cfg.Problems, c.lintAlerts(cfg)...)
+		problems = append(problems, c.lintNotifiers(cfg)...)
+		problems = append(problems, c.lintDashboards(cfg)...)
+
+		for _, problem := range problems {
+			c.cliGateway.Error(problem.String())
+		}
+
+		problemsCount += len(problems)
+	}
+
+	if problemsCount > 0 {
+		return fmt.Errorf("%d problem(s) found in %d configuration file(s)", problemsCount, len(files))
+	}
+
+	c.cliGateway.Info("configuration", fmt.Sprintf("no problems found in %d configuration file(s)", len(files)))
+
+	return nil
+}
+
+// lintAlerts parses the alert rules of the configuration and returns the problems found.
+func (c *ConfigController) lintAlerts(cfg config.Config) config.Problems {
+	var problems config.Problems
+
+	// ruleLines holds the lines of the rules by their names, the rules have to be named uniquely
+	ruleLines := make(map[string]int, len(cfg.Alerts.Rules))
+
+	for idx, ruleConfig := range cfg.Alerts.Rules {
+		path := fmt.Sprintf("alerts.rules[%d]", idx)
+
+		rule, err := alerter.NewRule(ruleConfig, cfg.Alerts.RepeatInterval)
+		if err != nil {
+			problems = append(problems, config.Problem{
+				File:    cfg.File,
+				Line:    cfg.Line(path),
+				Message: err.Error(),
+			})
+
+			continue
+		}
+
+		if line, ok := ruleLines[rule.Name]; ok {
+			problems = append(problems, config.Problem{
+				File:    cfg.File,
+				Line:    cfg.Line(path),
+				Message: fmt.Sprintf("alert rule %q: duplicate name, already defined at line %d", rule.Name, line),
+			})
+
+			continue
+		}
+
+		ruleLines[rule.Name] = cfg.Line(path)
+	}
+
+	return problems
+}
+
+// lintNotifiers creates the notifiers of the configuration and returns the problems found.
+func (c *ConfigController) lintNotifiers(cfg config.Config) config.Problems {
+	var problems config.Problems
+
+	for idx, notifierConfig := range cfg.Notifiers {
+		if _, err := notifiergw.NewGateway(c.cliGateway, notifierConfig); err != nil {
+			problems = append(problems, config.Problem{
+				File:    cfg.File,
+				Line:    cfg.Line(fmt.Sprintf("notifiers[%d]", idx)),
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return problems
+}
+
+// lintDashboards parses the dashboards layouts of the configuration and returns the problems found.
+// The problems are reported at the lines of the layout entries they are found in.
+func (c *ConfigController) lintDashboards(cfg config.Config) config.Problems {
+	var problems config.Problems
+
+	aliases := make([]string, 0, len(cfg.Dashboards))
+	for alias := range cfg.Dashboards {
+		aliases = append(aliases, alias)
+	}
+
+	sort.Strings(aliases)
+
+	for _, alias := range aliases {
+		_, err := dashboards.NewLayout(alias, cfg.Dashboards[alias])
+		if err == nil {
+			continue
+		}
+
+		path := "dashboards." + alias
+
+		var layoutErr *dashboards.LayoutError
+		if errors.As(err, &layoutErr) && layoutErr.Path != "" {
+			path += "." + layoutErr.Path
+		}
+
+		problems = append(problems, config.Problem{
+			File:    cfg.File,
+			Line:    cfg.Line(path),
+			Message: fmt.Sprintf("dashboard %q: %s", alias, err),
+		})
+	}
+
+	return problems
+}
@@ -0,0 +1,327 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/gateways/prometheusgw"
+	"github.com/bartosian/suimon/internal/core/gateways/rpcgw"
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/address"
+	"github.com/bartosian/suimon/static/templates"
+)
+
+// Init runs the interactive wizard creating the configuration file of the selected network. The public RPC
+// defaults are suggested from the bundled templates, every endpoint provided is checked for connectivity
+// before it is added, and the written file is linted.
+func (c *ConfigController) Init(options ports.ConfigInitOptions) error {
+	network, err := c.selectInitNetwork(options.Network)
+	if err != nil {
+		return err
+	}
+
+	dirPath, err := config.DirPath()
+	if err != nil {
+		return err
+	}
+
+	file := config.FilePath(dirPath, network)
+
+	if _, err := os.Stat(file); err == nil {
+		overwrite, err := c.cliGateway.Confirm(fmt.Sprintf("Configuration file %s already exists, overwrite it?", file), false)
+		if err != nil {
+			return err
+		}
+
+		if !overwrite {
+			return nil
+		}
+	}
+
+	cfg, err := config.NewTemplateConfig(network)
+	if err != nil {
+		return err
+	}
+
+	if cfg.PublicRPC, err = c.inputRPCAddresses("Public RPC addresses (comma separated):", cfg.PublicRPC, true); err != nil {
+		return err
+	}
+
+	if cfg.PublicExtendedRPC, err = c.inputRPCAddresses("Public extended RPC addresses (comma separated):", cfg.PublicExtendedRPC, false); err != nil {
+		return err
+	}
+
+	if cfg.FullNodes, err = c.inputFullNodes(); err != nil {
+		return err
+	}
+
+	if cfg.Validators, err = c.inputValidators(); err != nil {
+		return err
+	}
+
+	if err := config.WriteConfigFile(file, cfg); err != nil {
+		return err
+	}
+
+	c.cliGateway.Info("configuration file written", file)
+
+	return c.Lint(ports.ConfigLintOptions{Files: []string{file}})
+}
+
+// selectInitNetwork returns the network to create the configuration for, prompting the user if it is not provided.
+func (c *ConfigController) selectInitNetwork(network string) (string, error) {
+	networks := templates.Networks()
+
+	if network != "" {
+		network = strings.ToLower(strings.TrimSpace(network))
+
+		for _, templateNetwork := range networks {
+			if network == templateNetwork {
+				return network, nil
+			}
+		}
+
+		return "", fmt.Errorf("unsupported network %q, available networks: %s", network, strings.Join(networks, ", "))
+	}
+
+	selectedNetwork, err := c.cliGateway.SelectOne("Which network would you like to monitor?", cligw.NewSelectChoiceList(networks...))
+	if err != nil {
+		return "", err
+	}
+
+	return selectedNetwork.Value, nil
+}
+
+// inputRPCAddresses prompts the user for the comma separated list of RPC addresses, suggesting the defaults
+// from the template, and returns the addresses which passed the connectivity check or were kept by the user.
+func (c *ConfigController) inputRPCAddresses(question string, defaults []config.RPCEndpoint, required bool) ([]config.RPCEndpoint, error) {
+	answer, err := c.cliGateway.InputWithOpts(question, cligw.InputOpts{
+		Default: strings.Join(config.RPCAddresses(defaults), ","),
+		Validate: func(answer string) error {
+			addresses := splitAddresses(answer)
+			if required && len(addresses) == 0 {
+				return errors.New("at least one address is required")
+			}
+
+			return validateAddresses(addresses...)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0)
+
+	for _, addr := range splitAddresses(answer) {
+		keep, err := c.checkEndpoint(addr, c.checkRPC)
+		if err != nil {
+			return nil, err
+		}
+
+		if keep {
+			addresses = append(addresses, addr)
+		}
+	}
+
+	if required && len(addresses) == 0 {
+		return nil, errors.New("at least one public RPC address is required")
+	}
+
+	return config.NewRPCEndpoints(addresses...), nil
+}
+
+// inputFullNodes prompts the user for the full nodes to monitor until the user declines to add another one.
+func (c *ConfigController) inputFullNodes() ([]config.FullNode, error) {
+	nodes := make([]config.FullNode, 0)
+
+	for {
+		add, err := c.cliGateway.Confirm(addAnotherQuestion("full node", len(nodes)), len(nodes) == 0)
+		if err != nil || !add {
+			return nodes, err
+		}
+
+		var node config.FullNode
+
+		if node.JSONRPCAddress, err = c.cliGateway.InputWithOpts("Full node json-rpc-address (leave empty to skip):", cligw.InputOpts{
+			Validate: func(answer string) error { return validateAddresses(answer) },
+		}); err != nil {
+			return nil, err
+		}
+
+		if node.MetricsAddress, err = c.cliGateway.InputWithOpts("Full node metrics-address (leave empty to skip):", cligw.InputOpts{
+			Validate: func(answer string) error {
+				if answer == "" && node.JSONRPCAddress == "" {
+					return errors.New("at least one of json-rpc-address or metrics-address is required")
+				}
+
+				return validateAddresses(answer)
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		keep := true
+
+		if node.JSONRPCAddress != "" {
+			if keep, err = c.checkEndpoint(node.JSONRPCAddress, c.checkRPC); err != nil {
+				return nil, err
+			}
+		}
+
+		if keep && node.MetricsAddress != "" {
+			if keep, err = c.checkEndpoint(node.MetricsAddress, c.checkMetrics); err != nil {
+				return nil, err
+			}
+		}
+
+		if keep {
+			nodes = append(nodes, node)
+		}
+	}
+}
+
+// inputValidators prompts the user for the validators to monitor until the user declines to add another one.
+func (c *ConfigController) inputValidators() ([]config.Validator, error) {
+	validators := make([]config.Validator, 0)
+
+	for {
+		add, err := c.cliGateway.Confirm(addAnotherQuestion("validator", len(validators)), false)
+		if err != nil || !add {
+			return validators, err
+		}
+
+		var validator config.Validator
+
+		if validator.MetricsAddress, err = c.cliGateway.InputWithOpts("Validator metrics-address:", cligw.InputOpts{
+			Validate: func(answer string) error {
+				if answer == "" {
+					return errors.New("metrics-address is required")
+				}
+
+				return validateAddresses(answer)
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		keep, err := c.checkEndpoint(validator.MetricsAddress, c.checkMetrics)
+		if err != nil {
+			return nil, err
+		}
+
+		if keep {
+			validators = append(validators, validator)
+		}
+	}
+}
+
+// checkEndpoint runs the connectivity check against the address. If the check fails,
+// the user is asked whether the address should be kept in the configuration anyway.
+func (c *ConfigController) checkEndpoint(addr string, check func(host.AddressInfo) error) (bool, error) {
+	endpoint, err := address.ParseURL(addr)
+	if err != nil {
+		return false, err
+	}
+
+	addressInfo := host.AddressInfo{Endpoint: *endpoint, Ports: make(map[enums.PortType]string)}
+
+	if err := check(addressInfo); err != nil {
+		c.cliGateway.Warnf("%s is not reachable: %s", addr, err)
+
+		return c.cliGateway.Confirm(fmt.Sprintf("Keep %s in the configuration anyway?", addr), false)
+	}
+
+	c.cliGateway.Info("endpoint is reachable", addr)
+
+	return true, nil
+}
+
+// checkRPC requests the latest checkpoint from the RPC endpoint of the address.
+func (c *ConfigController) checkRPC(addressInfo host.AddressInfo) error {
+	if port := addressInfo.Endpoint.Port; port != nil {
+		addressInfo.Ports[enums.PortTypeRPC] = *port
+	}
+
+	url, err := addressInfo.GetUrlRPC()
+	if err != nil {
+		return err
+	}
+
+	rpcGateway, err := rpcgw.NewGateway(c.cliGateway, url, config.Connection{})
+	if err != nil {
+		return err
+	}
+
+	_, err = rpcGateway.CallFor(enums.RPCMethodGetLatestCheckpointSequenceNumber)
+
+	return err
+}
+
+// checkMetrics requests the uptime metric from the metrics endpoint of the address.
+func (c *ConfigController) checkMetrics(addressInfo host.AddressInfo) error {
+	if port := addressInfo.Endpoint.Port; port != nil {
+		addressInfo.Ports[enums.PortTypeMetrics] = *port
+	}
+
+	url, err := addressInfo.GetUrlPrometheus()
+	if err != nil {
+		return err
+	}
+
+	prometheusGateway, err := prometheusgw.NewGateway(c.cliGateway, url, config.Connection{})
+	if err != nil {
+		return err
+	}
+
+	_, err = prometheusGateway.CallFor(ports.Metrics{
+		enums.PrometheusMetricNameUptime: {
+			MetricType: enums.PrometheusMetricTypeCounter,
+		},
+	})
+
+	return err
+}
+
+// validateAddresses checks that all addresses can be parsed the same way they are parsed when the hosts are polled.
+// Empty addresses are skipped.
+func validateAddresses(addresses ...string) error {
+	for _, addr := range addresses {
+		if addr == "" {
+			continue
+		}
+
+		if _, err := address.ParseURL(addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitAddresses splits the comma separated list of addresses, dropping the empty entries.
+func splitAddresses(answer string) []string {
+	addresses := make([]string, 0)
+
+	for _, addr := range strings.Split(answer, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addresses = append(addresses, addr)
+		}
+	}
+
+	return addresses
+}
+
+// addAnotherQuestion returns the question asking the user whether to add a host of the specified kind.
+func addAnotherQuestion(kind string, added int) string {
+	if added == 0 {
+		return fmt.Sprintf("Would you like to add a %s to monitor?", kind)
+	}
+
+	return fmt.Sprintf("Would you like to add another %s?", kind)
+}
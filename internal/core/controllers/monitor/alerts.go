@@ -0,0 +1,137 @@
+package monitor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/service/alerter"
+	"github.com/bartosian/suimon/internal/core/gateways/notifiergw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// newAlertEngine creates the alert engine with the rules from the alerts section of the selected configuration.
+func (c *Controller) newAlertEngine() (*alerter.Engine, error) {
+	engine, err := alerter.NewEngine(c.selectedNetwork, c.selectedConfig.Alerts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse alert rules: %w", err)
+	}
+
+	return engine, nil
+}
+
+// newValidatorWatcher creates the watcher of the reports and the at risk records of the validators
+// configured with their Sui address or name.
+func (c *Controller) newValidatorWatcher() *alerter.ValidatorWatcher {
+	return alerter.NewValidatorWatcher(c.selectedNetwork, c.selectedConfig.Alerts, c.selectedConfig.Validators)
+}
+
+// initNotifiers creates the notifiers from the notifiers section of the selected configuration.
+// All invalid notifiers are reported in the returned error.
+func (c *Controller) initNotifiers() error {
+	var mErr *multierror.Error
+
+	notifiers := make([]ports.NotifierGateway, 0, len(c.selectedConfig.Notifiers))
+
+	for _, notifierConfig := range c.selectedConfig.Notifiers {
+		notifier, err := notifiergw.NewGateway(c.gateways.cli, notifierConfig)
+		if err != nil {
+			mErr = multierror.Append(mErr, err)
+
+			continue
+		}
+
+		notifiers = append(notifiers, notifier)
+	}
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmt.Errorf("failed to create notifiers: %w", err)
+	}
+
+	c.gateways.notifiers = notifiers
+
+	return nil
+}
+
+// evaluateAlerts applies the alert rules to the hosts of the polled tables, compares the reports and the at risk records
+// of the tracked validators in the system state of the reference RPC with the previous poll and reports the resulting events.
+func (c *Controller) evaluateAlerts(engine *alerter.Engine, watcher *alerter.ValidatorWatcher) {
+	now := time.Now()
+	rpcHost := c.referenceRPC()
+
+	var events []alerter.Event
+
+	for _, table := range pollingTables {
+		hosts, err := c.getHostsByTableType(table)
+		if err != nil {
+			continue
+		}
+
+		events = append(events, engine.Evaluate(table, hosts, rpcHost, now)...)
+	}
+
+	if rpcHost.Metrics.Updated {
+		events = append(events, watcher.Watch(&rpcHost.Metrics.SystemState, now)...)
+	}
+
+	notifications := make([]ports.Notification, 0, len(events))
+
+	for _, event := range events {
+		c.reportAlertEvent(event)
+
+		notifications = append(notifications, event.Notification())
+	}
+
+	c.notify(notifications...)
+}
+
+// notify sends the notifications to all configured notifiers and prints the errors of the failed deliveries.
+func (c *Controller) notify(notifications ...ports.Notification) {
+	for _, err := range c.sendNotifications(notifications...) {
+		c.gateways.cli.Errorf("failed to send notification: %s", err)
+	}
+}
+
+// sendNotifications sends the notifications to all configured notifiers concurrently, waits for them to be delivered
+// and returns the errors of the failed deliveries.
+func (c *Controller) sendNotifications(notifications ...ports.Notification) []error {
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs []error
+	)
+
+	for _, notification := range notifications {
+		for _, notifier := range c.gateways.notifiers {
+			wg.Add(1)
+
+			go func(notifier ports.NotifierGateway, notification ports.Notification) {
+				defer wg.Done()
+
+				if err := notifier.Notify(notification); err != nil {
+					lock.Lock()
+					errs = append(errs, err)
+					lock.Unlock()
+				}
+			}(notifier, notification)
+		}
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// reportAlertEvent prints the alert event to the terminal.
+func (c *Controller) reportAlertEvent(event alerter.Event) {
+	if event.State == enums.AlertStateResolved {
+		c.gateways.cli.Info("alert resolved", event.String())
+
+		return
+	}
+
+	c.gateways.cli.Error(event.String())
+}
@@ -2,18 +2,24 @@ package monitor
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"sync"
 
 	"github.com/bartosian/suimon/internal/core/domain/config"
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
 	"github.com/bartosian/suimon/internal/core/ports"
 )
 
 type (
 	Gateways struct {
-		cli *cligw.Gateway
+		cli       *cligw.Gateway
+		notifiers []ports.NotifierGateway
+		history   ports.HistoryGateway
 	}
 
 	Hosts struct {
@@ -21,40 +27,68 @@ type (
 		rpc         []host.Host
 		node        []host.Host
 		validator   []host.Host
+
+		// reference holds the RPC URL of the host selected as the reference on the last poll.
+		reference string
+
+		// referenceIdx holds the index of the reference in the RPC hosts, it is guarded by the controller lock.
+		referenceIdx int
+
+		// systemState holds the system state reported by the reference on the last poll, it is used to detect the epoch changes.
+		systemState *metrics.SuiSystemState
 	}
 
 	Builders struct {
-		static  map[enums.TableType]ports.Builder
+		static  map[enums.TableType]ports.StaticBuilder
 		dynamic map[enums.TableType]ports.Builder
 	}
 
+	Output struct {
+		format enums.OutputFormat
+		path   string
+		writer io.Writer
+	}
+
 	Controller struct {
 		lock sync.RWMutex
 
+		// longRunning is set by the modes polling the hosts continuously. In these modes
+		// the hosts which failed to respond are kept, so they are reported as unhealthy.
+		longRunning bool
+
+		selectedNetwork   string
 		selectedConfig    config.Config
 		selectedTables    []enums.TableType
 		selectedDashboard enums.TableType
+		selectedHost      string
+		selectedSubscribe bool
+
+		// layouts holds the layouts of the dynamic dashboards parsed from the selected configuration.
+		layouts map[enums.TableType]dashboards.Layout
 
 		configs  map[string]config.Config
 		hosts    Hosts
 		gateways Gateways
 		builders Builders
+		output   Output
 	}
 )
 
-func NewController(
-	config map[string]config.Config,
-	cliGW *cligw.Gateway,
-) *Controller {
+// NewController creates the monitor controller. The configuration files are read
+// when the network is selected, so the commands not using them work with broken files too.
+func NewController(cliGW *cligw.Gateway) *Controller {
 	return &Controller{
-		configs: config,
 		gateways: Gateways{
 			cli: cliGW,
 		},
 		builders: Builders{
-			static:  make(map[enums.TableType]ports.Builder),
+			static:  make(map[enums.TableType]ports.StaticBuilder),
 			dynamic: make(map[enums.TableType]ports.Builder),
 		},
+		output: Output{
+			format: enums.OutputFormatTable,
+			writer: os.Stdout,
+		},
 	}
 }
 
@@ -70,12 +104,12 @@ func (c *Controller) getHostsByTableType(table enums.TableType) (hosts []host.Ho
 	case enums.TableTypeValidator:
 		return c.hosts.validator, nil
 	case enums.TableTypeActiveValidators:
-		return c.hosts.rpc[:1], nil
+		return c.referenceHosts(), nil
 	case enums.TableTypeGasPriceAndSubsidy,
 		enums.TableTypeValidatorsParams,
 		enums.TableTypeValidatorsAtRisk,
 		enums.TableTypeValidatorReports:
-		return c.hosts.rpc[:1], nil
+		return c.referenceHosts(), nil
 	case enums.TableTypeRPC:
 		return c.hosts.rpc, nil
 	case enums.TableTypeEpochsHistory:
@@ -98,6 +132,7 @@ func (c *Controller) setHostsByTableType(table enums.TableType, hosts []host.Hos
 		c.hosts.validator = hosts
 	case enums.TableTypeRPC:
 		c.hosts.rpc = hosts
+		c.hosts.referenceIdx = 0
 	case enums.TableTypeEpochsHistory:
 		c.hosts.extendedRPC = hosts
 	default:
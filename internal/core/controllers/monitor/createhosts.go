@@ -19,6 +19,7 @@ type responseWithError struct {
 
 // createHosts creates a list of Host objects based on the specified table type and address information.
 // The function creates a new Host object for each address in the specified list and sets the Host's internal state based on the specified table type.
+// In long-running mode the hosts which failed to respond are kept in the list, so they can be polled again later.
 // Returns a slice of Host objects and an error value if the creation process fails for any reason.
 func (c *Controller) createHosts(table enums.TableType, addresses []host.AddressInfo) ([]host.Host, error) {
 	hosts := make([]host.Host, 0, len(addresses))
@@ -29,7 +30,7 @@ func (c *Controller) createHosts(table enums.TableType, addresses []host.Address
 	var wg sync.WaitGroup
 
 	for _, addressInfo := range addresses {
-		address := addressInfo.Endpoint.Address
+		address := addressInfo.Key()
 		if _, ok := processedAddresses[address]; ok {
 			continue
 		}
@@ -51,7 +52,13 @@ func (c *Controller) createHosts(table enums.TableType, addresses []host.Address
 				return
 			}
 
-			rpcGateway := rpcgw.NewGateway(c.gateways.cli, rpcUrl)
+			rpcGateway, err := rpcgw.NewGateway(c.gateways.cli, rpcUrl, addressInfo.Connection)
+			if err != nil {
+				result.err = err
+				respChan <- result
+
+				return
+			}
 
 			metricsUrl, err := addressInfo.GetUrlPrometheus()
 			if err != nil {
@@ -61,7 +68,14 @@ func (c *Controller) createHosts(table enums.TableType, addresses []host.Address
 				return
 			}
 
-			prometheusGateway := prometheusgw.NewGateway(c.gateways.cli, metricsUrl)
+			prometheusGateway, err := prometheusgw.NewGateway(c.gateways.cli, metricsUrl, addressInfo.Connection)
+			if err != nil {
+				result.err = err
+				respChan <- result
+
+				return
+			}
+
 			geoGateway := geogw.NewGateway(c.gateways.cli, c.selectedConfig.IPLookup.AccessToken)
 
 			createdHost := host.NewHost(table, addressInfo, rpcGateway, geoGateway, prometheusGateway, c.gateways.cli)
@@ -98,6 +112,10 @@ func (c *Controller) createHosts(table enums.TableType, addresses []host.Address
 		if result.err != nil {
 			mErr = multierror.Append(mErr, result.err)
 
+			if c.longRunning && result.response != nil {
+				hosts = append(hosts, *result.response)
+			}
+
 			continue
 		}
 
@@ -1,25 +1,48 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
+	"sort"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
 	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder"
+	"github.com/bartosian/suimon/internal/core/gateways/subscriptiongw"
+	"github.com/bartosian/suimon/internal/core/ports"
 )
 
 // Dynamic is a method of the Controller struct, responsible for initializing and rendering dashboards
-// based on the configuration data.
+// based on the configuration data. The epoch changes are watched while the dashboards are rendered,
+// the summaries of the ended epochs are sent to the notifiers of the configuration.
 func (c *Controller) Dynamic() error {
+	// Parse the dashboards layouts and the notifiers first, so the invalid ones are reported before the hosts are polled.
+	if err := c.parseDashboardLayouts(); err != nil {
+		return err
+	}
+
+	if err := c.initNotifiers(); err != nil {
+		return err
+	}
+
 	// Parse the configuration data.
 	if err := c.ParseConfigData(enums.MonitorTypeDynamic); err != nil {
 		return err
 	}
 
+	// Parse the data of the other dashboards, so the running dashboard can be switched to them.
+	c.parseNavigationData()
+
 	// Initialize dashboard based on the configuration data.
 	if err := c.InitDashboard(); err != nil {
 		return err
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go c.watchEpochChange(ctx)
+
 	// Render the dashboard and return error if any
 	return c.RenderDashboards()
 }
@@ -30,17 +53,163 @@ func (c *Controller) Dynamic() error {
 func (c *Controller) InitDashboard() error {
 	selectedDashboard := c.selectedDashboard
 
+	if selectedDashboard == enums.TableTypeFleet {
+		return c.initFleetDashboard()
+	}
+
 	host, err := c.selectHostForDashboard()
 	if err != nil {
 		return err
 	}
 
-	builder, err := dashboardbuilder.NewBuilder(selectedDashboard, *host, c.gateways.cli)
+	subscription, err := c.dashboardSubscription(host)
+	if err != nil {
+		return err
+	}
+
+	builder, err := dashboardbuilder.NewBuilder(selectedDashboard, *host, c.referenceRPC(), c.dashboardFallbacks(selectedDashboard, host), subscription, c.gateways.cli)
 	if err != nil {
 		return fmt.Errorf("error creating dashboard %s: %w", selectedDashboard, err)
 	}
 
+	builder.SetDashboards(c.navigationDashboards())
+	builder.SetLayouts(c.layouts)
+
 	c.builders.dynamic[selectedDashboard] = builder
 
 	return builder.Init()
 }
+
+// initFleetDashboard initializes the fleet dashboard rendering all the full nodes and validators of the configuration.
+func (c *Controller) initFleetDashboard() error {
+	builder, err := dashboardbuilder.NewFleetBuilder(c.fleetHosts(), c.referenceRPC(), c.gateways.cli)
+	if err != nil {
+		return fmt.Errorf("error creating dashboard %s: %w", enums.TableTypeFleet, err)
+	}
+
+	builder.SetDashboards(c.navigationDashboards())
+	builder.SetLayouts(c.layouts)
+
+	c.builders.dynamic[enums.TableTypeFleet] = builder
+
+	return builder.Init()
+}
+
+// fleetHosts returns all the full nodes and validators of the configuration, the full nodes go first.
+func (c *Controller) fleetHosts() []host.Host {
+	c.lock.RLock()
+	fleet := make([]host.Host, 0, len(c.hosts.node)+len(c.hosts.validator))
+	fleet = append(fleet, c.hosts.node...)
+	fleet = append(fleet, c.hosts.validator...)
+	c.lock.RUnlock()
+
+	// the hosts are polled concurrently and come in the order of their responses, so they are ordered
+	// by their addresses to keep the tiles in place across the runs
+	sort.SliceStable(fleet, func(left, right int) bool {
+		if fleet[left].TableType != fleet[right].TableType {
+			return fleet[left].TableType == enums.TableTypeNode
+		}
+
+		return fleet[left].Key() < fleet[right].Key()
+	})
+
+	return fleet
+}
+
+// navigationDashboards returns the dynamic dashboards the running dashboard can be switched to along with the copies
+// of their hosts, so the builder keeps the hosts state on its own. The network wide dashboards render the reference RPC
+// host and fail over like the selected dashboard does, the dashboards without hosts are not returned.
+func (c *Controller) navigationDashboards() []dashboardbuilder.Dashboard {
+	dashboards := make([]dashboardbuilder.Dashboard, 0, len(dynamicDashboards))
+
+	for _, dashboard := range dynamicDashboards {
+		hosts := c.navigationHosts(dashboard)
+		if len(hosts) == 0 {
+			continue
+		}
+
+		dashboards = append(dashboards, dashboardbuilder.Dashboard{
+			TableType: dashboard,
+			Hosts:     hosts,
+			Fallbacks: c.dashboardFallbacks(dashboard, &hosts[0]),
+		})
+	}
+
+	return dashboards
+}
+
+// navigationHosts returns the copy of the hosts the dashboard of the specified type can render.
+// The hosts are looked up directly, since the dashboards data might not be parsed.
+func (c *Controller) navigationHosts(dashboard enums.TableType) []host.Host {
+	if dashboard == enums.TableTypeFleet {
+		return c.fleetHosts()
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var hosts []host.Host
+
+	switch dashboard {
+	case enums.TableTypeNode:
+		hosts = c.hosts.node
+	case enums.TableTypeValidator:
+		hosts = c.hosts.validator
+	case enums.TableTypeRPC:
+		hosts = c.hosts.rpc
+	case enums.TableTypeGasPriceAndSubsidy, enums.TableTypeActiveValidators:
+		hosts = c.referenceHosts()
+	case enums.TableTypeEpochsHistory:
+		if len(c.hosts.extendedRPC) > 0 {
+			hosts = c.hosts.extendedRPC[:1]
+		}
+	}
+
+	return append([]host.Host(nil), hosts...)
+}
+
+// dashboardSubscription returns the gateway subscribing to the events of the host if the subscription is selected,
+// the WebSocket endpoint is served on the RPC address of the host.
+func (c *Controller) dashboardSubscription(host *host.Host) (ports.SubscriptionGateway, error) {
+	if !c.selectedSubscribe {
+		return nil, nil
+	}
+
+	rpcURL, err := host.GetUrlRPC()
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptiongw.NewGateway(c.gateways.cli, rpcURL, host.Connection)
+}
+
+// dashboardFallbacks returns the hosts the dashboard fails over to when its host stops responding.
+// The network wide dashboards are served by the reference RPC, so they fail over to the next RPC endpoints
+// in the order of their progress, and the epochs history dashboard fails over to the other extended RPC endpoints.
+// The endpoints which failed to respond so far are kept, since the fallbacks are refreshed and ranked again on every failover.
+// The dashboards of the specific hosts do not fail over.
+func (c *Controller) dashboardFallbacks(dashboard enums.TableType, dashboardHost *host.Host) []host.Host {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var candidates []host.Host
+
+	switch dashboard {
+	case enums.TableTypeGasPriceAndSubsidy, enums.TableTypeActiveValidators:
+		candidates = c.rankedFallbackRPC()
+	case enums.TableTypeEpochsHistory:
+		candidates = c.hosts.extendedRPC
+	default:
+		return nil
+	}
+
+	fallbacks := make([]host.Host, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if candidate.Key() != dashboardHost.Key() {
+			fallbacks = append(fallbacks, candidate)
+		}
+	}
+
+	return fallbacks
+}
@@ -0,0 +1,141 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	// ruleEpochSummary is the name of the notification sent with the summary of the ended epoch.
+	ruleEpochSummary = "epoch-summary"
+	// epochCheckInterval is the interval the epoch is checked on while the dynamic dashboard is rendered.
+	epochCheckInterval = 30 * time.Second
+)
+
+// checkEpochChange compares the epoch reported by the reference RPC with the one observed on the previous poll and,
+// once the epoch changes, reports the summary of the ended epoch to the terminal and the notifiers.
+func (c *Controller) checkEpochChange() {
+	rpcHost := c.referenceRPC()
+
+	summary, err := c.epochChange(rpcHost)
+	if err != nil {
+		c.gateways.cli.Errorf("%s", err)
+	}
+
+	if summary == nil {
+		return
+	}
+
+	c.gateways.cli.Info("epoch summary", summary.String())
+
+	c.notify(c.epochSummaryNotification(summary, rpcHost.Endpoint.Address))
+}
+
+// watchEpochChange checks the epoch reported by the reference RPC on the epoch check interval until the context is done
+// and sends the summary of the ended epoch to the notifiers. It is run along with the dynamic dashboard, which renders
+// on the terminal, so nothing is printed and the epoch is watched only if the notifiers are configured. The copy of
+// the reference RPC is refreshed, so the hosts of the controller are not updated while the dashboard polls their copies.
+func (c *Controller) watchEpochChange(ctx context.Context) {
+	if len(c.gateways.notifiers) == 0 {
+		return
+	}
+
+	rpcHost := c.referenceRPC()
+
+	// the epoch the dashboard is started in is recorded, so its end is reported
+	_, _ = c.epochChange(rpcHost)
+
+	ticker := time.NewTicker(epochCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := rpcHost.GetMetrics(); err != nil {
+				continue
+			}
+
+			// The errors are not reported, since the terminal renders the dashboard.
+			if summary, _ := c.epochChange(rpcHost); summary != nil {
+				_ = c.sendNotifications(c.epochSummaryNotification(summary, rpcHost.Endpoint.Address))
+			}
+		}
+	}
+}
+
+// epochChange compares the epoch reported by the RPC host with the one observed on the previous check and returns
+// the summary of the ended epoch once the epoch changes, nil otherwise. The end of epoch info is requested from
+// the public extended RPC, if one is configured. If the request fails, the summary is returned without the totals
+// along with the error.
+func (c *Controller) epochChange(rpcHost host.Host) (*metrics.EpochSummary, error) {
+	if !rpcHost.Metrics.Updated {
+		return nil, nil
+	}
+
+	current := rpcHost.Metrics.SystemState
+
+	c.lock.Lock()
+	previous := c.hosts.systemState
+	c.hosts.systemState = &current
+	c.lock.Unlock()
+
+	if previous == nil || previous.Epoch == current.Epoch {
+		return nil, nil
+	}
+
+	epochInfo, infoErr := c.getEpochInfo(previous.Epoch)
+
+	summary, err := metrics.NewEpochSummary(previous, &current, epochInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize epoch %s: %w", previous.Epoch, err)
+	}
+
+	return summary, infoErr
+}
+
+// getEpochInfo requests the info of the specified epoch from the public extended RPC with a single request.
+// It returns nil if no public extended RPC is configured.
+func (c *Controller) getEpochInfo(epoch string) (*metrics.EpochInfo, error) {
+	if len(c.selectedConfig.PublicExtendedRPC) == 0 {
+		return nil, nil
+	}
+
+	epochNumber, err := strconv.Atoi(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected epoch value: %s", epoch)
+	}
+
+	_, epochInfo, err := c.epochRPC(epochNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the info of epoch %s: %w", epoch, err)
+	}
+
+	return epochInfo, nil
+}
+
+// epochSummaryNotification creates the notification sent with the summary of the ended epoch reported by the host on the address.
+func (c *Controller) epochSummaryNotification(summary *metrics.EpochSummary, address string) ports.Notification {
+	now := time.Now()
+
+	return ports.Notification{
+		State:     enums.AlertStateInfo.ToString(),
+		Rule:      ruleEpochSummary,
+		Condition: fmt.Sprintf("epoch %d ended", summary.Epoch),
+		Network:   c.selectedNetwork,
+		Table:     enums.TableTypeEpochsHistory.Alias(),
+		Host:      address,
+		Metric:    "summary",
+		Value:     summary.String(),
+		Since:     now,
+		At:        now,
+	}
+}
@@ -0,0 +1,129 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/service/exporter"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	exporterMetricsPath     = "/metrics"
+	exporterShutdownTimeout = 5 * time.Second
+	exporterReadTimeout     = 10 * time.Second
+)
+
+// Export polls the hosts of the selected configuration on the provided interval and serves
+// their health and derived metrics as Prometheus gauges on the provided listen address.
+// The alert rules from the configuration, if any, are evaluated after every poll.
+// The function blocks until the process is interrupted or the HTTP server fails.
+func (c *Controller) Export(options ports.ExporterOptions) error {
+	if options.Interval <= 0 {
+		return fmt.Errorf("invalid polling interval provided: %s", options.Interval)
+	}
+
+	if err := c.selectNetwork(options.Network); err != nil {
+		return err
+	}
+
+	engine, err := c.newAlertEngine()
+	if err != nil {
+		return err
+	}
+
+	watcher := c.newValidatorWatcher()
+
+	if err := c.initNotifiers(); err != nil {
+		return err
+	}
+
+	if err := c.initHistory(); err != nil {
+		return err
+	}
+
+	c.longRunning = true
+	c.selectedTables = pollingTables
+
+	if err := c.ParseConfigData(enums.MonitorTypeStatic); err != nil {
+		return err
+	}
+
+	metricsExporter := exporter.NewExporter(c.selectedNetwork)
+	c.updateExporter(metricsExporter)
+	c.checkEpochChange()
+	c.evaluateAlerts(engine, watcher)
+	c.recordHistory()
+
+	mux := http.NewServeMux()
+	mux.Handle(exporterMetricsPath, metricsExporter.Handler())
+
+	server := &http.Server{
+		Addr:              options.Listen,
+		Handler:           mux,
+		ReadHeaderTimeout: exporterReadTimeout,
+	}
+
+	serverErr := make(chan error, 1)
+
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+
+	c.gateways.cli.Info("serving metrics", fmt.Sprintf("http://%s%s", options.Listen, exporterMetricsPath))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), exporterShutdownTimeout)
+			defer cancel()
+
+			return server.Shutdown(shutdownCtx)
+		case err := <-serverErr:
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+
+			return fmt.Errorf("failed to serve metrics: %w", err)
+		case <-ticker.C:
+			if err := c.refreshHostsData(pollingTables...); err != nil {
+				c.gateways.cli.Errorf("failed to refresh hosts data: %s", err)
+			}
+
+			c.updateExporter(metricsExporter)
+			c.checkEpochChange()
+			c.evaluateAlerts(engine, watcher)
+			c.recordHistory()
+		}
+	}
+}
+
+// updateExporter sets the exporter gauges for the hosts of all exported tables.
+// The reference gas price statistics are exported as reported by the reference RPC serving the gas price table.
+func (c *Controller) updateExporter(metricsExporter *exporter.Exporter) {
+	for _, table := range pollingTables {
+		hosts, err := c.getHostsByTableType(table)
+		if err != nil {
+			continue
+		}
+
+		metricsExporter.Update(table, hosts)
+	}
+
+	if hosts, err := c.getHostsByTableType(enums.TableTypeGasPriceAndSubsidy); err == nil && len(hosts) > 0 {
+		metricsExporter.UpdateGasPrice(&hosts[0])
+	}
+}
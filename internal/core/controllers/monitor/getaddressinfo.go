@@ -53,6 +53,8 @@ func (c *Controller) getNodeAddresses(parser addressParser) (addresses []host.Ad
 
 	for _, node := range nodesConfig {
 		addressRPC, addressMetrics := node.JSONRPCAddress, node.MetricsAddress
+		connection := node.Connection.Merge(c.selectedConfig.Connection)
+		health := node.Health.Merge(c.selectedConfig.Health)
 
 		if addressRPC == "" && addressMetrics == "" {
 			return nil, errors.New("invalid format for full-node in dashboards file: at least one of json-rpc-address or metrics-address is required")
@@ -69,7 +71,7 @@ func (c *Controller) getNodeAddresses(parser addressParser) (addresses []host.Ad
 				return nil, fmt.Errorf("invalid format for full-node json-rpc-address in config file: %w", err)
 			}
 
-			addressInfo = &host.AddressInfo{Endpoint: *endpointRPC, Ports: make(map[enums.PortType]string)}
+			addressInfo = &host.AddressInfo{Endpoint: *endpointRPC, Ports: make(map[enums.PortType]string), Connection: connection, Health: health}
 
 			if endpointRPC.Port != nil {
 				addressInfo.Ports[enums.PortTypeRPC] = *endpointRPC.Port
@@ -82,7 +84,7 @@ func (c *Controller) getNodeAddresses(parser addressParser) (addresses []host.Ad
 			}
 
 			if addressInfo == nil {
-				addressInfo = &host.AddressInfo{Endpoint: *endpointMetrics, Ports: make(map[enums.PortType]string)}
+				addressInfo = &host.AddressInfo{Endpoint: *endpointMetrics, Ports: make(map[enums.PortType]string), Connection: connection, Health: health}
 			}
 
 			if endpointMetrics.Port != nil {
@@ -115,7 +117,14 @@ func (c *Controller) getValidatorAddresses(parser addressParser) (addresses []ho
 			return nil, fmt.Errorf("invalid format for validator metrics-address in config file: %w", err)
 		}
 
-		addressInfo := host.AddressInfo{Endpoint: *endpointMetrics, Ports: make(map[enums.PortType]string)}
+		addressInfo := host.AddressInfo{
+			Endpoint:      *endpointMetrics,
+			Ports:         make(map[enums.PortType]string),
+			Connection:    validator.Connection.Merge(c.selectedConfig.Connection),
+			Health:        validator.Health.Merge(c.selectedConfig.Health),
+			SuiAddress:    validator.SuiAddress,
+			ValidatorName: validator.Name,
+		}
 
 		if endpointMetrics.Port != nil {
 			addressInfo.Ports[enums.PortTypeMetrics] = *endpointMetrics.Port
@@ -135,12 +144,17 @@ func (c *Controller) getRPCAddresses(parser addressParser) (addresses []host.Add
 	}
 
 	for _, rpc := range rpcConfig {
-		endpoint, err := parser(rpc)
+		endpoint, err := parser(rpc.Address)
 		if err != nil {
 			return nil, fmt.Errorf("invalid format for public-rpc in config file: %w", err)
 		}
 
-		addressInfo := host.AddressInfo{Endpoint: *endpoint, Ports: make(map[enums.PortType]string)}
+		addressInfo := host.AddressInfo{
+			Endpoint:   *endpoint,
+			Ports:      make(map[enums.PortType]string),
+			Connection: rpc.Connection.Merge(c.selectedConfig.Connection),
+			Health:     rpc.Health.Merge(c.selectedConfig.Health),
+		}
 		if endpoint.Port != nil {
 			addressInfo.Ports[enums.PortTypeRPC] = *endpoint.Port
 		}
@@ -159,12 +173,17 @@ func (c *Controller) getExtendedRPCAddresses(parser addressParser) (addresses []
 	}
 
 	for _, rpc := range rpcConfig {
-		endpoint, err := parser(rpc)
+		endpoint, err := parser(rpc.Address)
 		if err != nil {
 			return nil, fmt.Errorf("invalid format for public-extended-rpc in config file: %w", err)
 		}
 
-		addressInfo := host.AddressInfo{Endpoint: *endpoint, Ports: make(map[enums.PortType]string)}
+		addressInfo := host.AddressInfo{
+			Endpoint:   *endpoint,
+			Ports:      make(map[enums.PortType]string),
+			Connection: rpc.Connection.Merge(c.selectedConfig.Connection),
+			Health:     rpc.Health.Merge(c.selectedConfig.Health),
+		}
 		if endpoint.Port != nil {
 			addressInfo.Ports[enums.PortTypeRPC] = *endpoint.Port
 		}
@@ -0,0 +1,276 @@
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder"
+	"github.com/bartosian/suimon/internal/core/gateways/historygw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	historyDirDefault       = "data"
+	historyRetentionDefault = 7 * 24 * time.Hour
+	historyStatusMetric     = "status"
+)
+
+// historyMetrics holds the metrics recorded to the history on every poll of the hosts.
+var historyMetrics = []enums.MetricType{
+	enums.MetricTypeTxSyncPercentage,
+	enums.MetricTypeCheckSyncPercentage,
+	enums.MetricTypeTotalTransactionBlocks,
+	enums.MetricTypeTransactionsPerSecond,
+	enums.MetricTypeLatestCheckpoint,
+	enums.MetricTypeHighestKnownCheckpoint,
+	enums.MetricTypeHighestSyncedCheckpoint,
+	enums.MetricTypeLastExecutedCheckpoint,
+	enums.MetricTypeCheckpointExecBacklog,
+	enums.MetricTypeCheckpointSyncBacklog,
+	enums.MetricTypeCheckpointsPerSecond,
+	enums.MetricTypeCurrentEpoch,
+	enums.MetricTypeSuiNetworkPeers,
+	enums.MetricTypePrimaryNetworkPeers,
+	enums.MetricTypeWorkerNetworkPeers,
+	enums.MetricTypeCurrentRound,
+	enums.MetricTypeHighestProcessedRound,
+	enums.MetricTypeLastCommittedRound,
+	enums.MetricTypeCertificatesCreated,
+	enums.MetricTypeSkippedConsensusTransactions,
+	enums.MetricTypeTotalSignatureErrors,
+}
+
+// newHistoryGateway creates the history store with the settings from the history section of the selected configuration.
+func (c *Controller) newHistoryGateway() (ports.HistoryGateway, error) {
+	historyConfig := c.selectedConfig.History
+
+	dirPath := historyConfig.Path
+	if dirPath == "" {
+		configDir, err := config.DirPath()
+		if err != nil {
+			return nil, err
+		}
+
+		dirPath = filepath.Join(configDir, historyDirDefault)
+	}
+
+	retention := historyConfig.Retention
+	if retention == 0 {
+		retention = historyRetentionDefault
+	}
+
+	return historygw.NewGateway(c.gateways.cli, dirPath, retention)
+}
+
+// initHistory creates the history store the polled hosts are recorded to, unless it is disabled in the configuration.
+func (c *Controller) initHistory() error {
+	if enabled := c.selectedConfig.History.Enabled; enabled != nil && !*enabled {
+		return nil
+	}
+
+	history, err := c.newHistoryGateway()
+	if err != nil {
+		return fmt.Errorf("failed to create history store: %w", err)
+	}
+
+	c.gateways.history = history
+
+	return nil
+}
+
+// recordHistory records the current data of the hosts of the polled tables to the history store.
+// The metrics are recorded for the hosts which responded only, the status is recorded for all hosts.
+func (c *Controller) recordHistory() {
+	if c.gateways.history == nil {
+		return
+	}
+
+	now := time.Now()
+
+	var records []ports.HistoryRecord
+
+	for _, table := range pollingTables {
+		hosts, err := c.getHostsByTableType(table)
+		if err != nil {
+			continue
+		}
+
+		for idx := range hosts {
+			hostData := &hosts[idx]
+
+			record := ports.HistoryRecord{
+				Table:     table.Alias(),
+				Host:      hostData.HostAddress(),
+				Key:       hostData.Key(),
+				Timestamp: now,
+				Status:    hostData.Status.ToLabel(),
+			}
+
+			for _, check := range hostData.FailedChecks {
+				record.Reasons = append(record.Reasons, check.String())
+			}
+
+			if hostData.Metrics.Updated {
+				record.Values = make(map[string]float64, len(historyMetrics))
+
+				for _, metric := range historyMetrics {
+					if value, err := hostData.Metrics.GetNumericValue(metric); err == nil {
+						record.Values[metric.Alias()] = value
+					}
+				}
+			}
+
+			records = append(records, record)
+		}
+	}
+
+	if err := c.gateways.history.Record(c.selectedNetwork, records); err != nil {
+		c.gateways.cli.Errorf("failed to record history: %s", err)
+	}
+}
+
+// History prints the recorded history of the metric for the hosts of the selected configuration matching the provided filters.
+func (c *Controller) History(options ports.HistoryOptions) error {
+	if options.Since <= 0 {
+		return fmt.Errorf("invalid history period provided: %s", options.Since)
+	}
+
+	format, ok := enums.OutputFormatFromString(options.Output)
+	if !ok {
+		return fmt.Errorf("unsupported output format %q, use one of: %v", options.Output, enums.OutputFormats())
+	}
+
+	metricName, err := parseHistoryMetric(options.Metric)
+	if err != nil {
+		return err
+	}
+
+	var tableAlias string
+
+	if options.Table != "" {
+		table, ok := enums.TableTypeFromAlias(options.Table)
+		if !ok || !isPollingTable(table) {
+			return fmt.Errorf("unsupported table %q, use one of: rpc, node, validator", options.Table)
+		}
+
+		tableAlias = table.Alias()
+	}
+
+	if err := c.selectNetwork(options.Network); err != nil {
+		return err
+	}
+
+	history, err := c.newHistoryGateway()
+	if err != nil {
+		return fmt.Errorf("failed to open history store: %w", err)
+	}
+
+	records, err := history.Query(ports.HistoryQuery{
+		Network: c.selectedNetwork,
+		Table:   tableAlias,
+		Host:    options.Host,
+		Since:   time.Now().Add(-options.Since),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		return errors.New("no history recorded for the provided filters, the history is recorded by the watch and exporter commands")
+	}
+
+	return tablebuilder.WriteRecords(c.output.writer, format, []ports.TableRecords{historyTableRecords(records, metricName, format)})
+}
+
+// parseHistoryMetric resolves the alias of the queried metric, empty metric means only the status is queried.
+func parseHistoryMetric(name string) (string, error) {
+	if name == "" || strings.EqualFold(name, historyStatusMetric) {
+		return "", nil
+	}
+
+	metric := enums.MetricTypeFromName(name)
+
+	for _, historyMetric := range historyMetrics {
+		if historyMetric == metric {
+			return metric.Alias(), nil
+		}
+	}
+
+	supported := make([]string, 0, len(historyMetrics)+1)
+	supported = append(supported, historyStatusMetric)
+
+	for _, historyMetric := range historyMetrics {
+		supported = append(supported, historyMetric.Alias())
+	}
+
+	return "", fmt.Errorf("unsupported metric %q, use one of: %s", name, strings.Join(supported, ", "))
+}
+
+// historyTableRecords converts the history records to the table records with the status, the reason and the queried metric columns.
+func historyTableRecords(records []ports.HistoryRecord, metricName string, format enums.OutputFormat) ports.TableRecords {
+	timeLayout := time.RFC3339
+	if format == enums.OutputFormatTable {
+		timeLayout = time.DateTime
+	}
+
+	columns := []enums.ColumnName{
+		enums.ColumnNameHistoryTime,
+		enums.ColumnNameHistoryTable,
+		enums.ColumnNameAddress,
+		enums.ColumnNameHealth,
+		enums.ColumnNameReason,
+	}
+
+	metricColumn := enums.ColumnName(strings.ToUpper(strings.ReplaceAll(metricName, "-", " ")))
+	if metricName != "" {
+		columns = append(columns, metricColumn)
+	}
+
+	tableRecords := ports.TableRecords{
+		Table:   enums.TableTypeMetricHistory,
+		Columns: columns,
+		Rows:    make([]ports.TableRecord, 0, len(records)),
+	}
+
+	for _, record := range records {
+		row := ports.TableRecord{
+			enums.ColumnNameHistoryTime:  record.Timestamp.Local().Format(timeLayout),
+			enums.ColumnNameHistoryTable: record.Table,
+			enums.ColumnNameAddress:      record.Host,
+			enums.ColumnNameHealth:       record.Status,
+			enums.ColumnNameReason:       nil,
+		}
+
+		if len(record.Reasons) > 0 {
+			row[enums.ColumnNameReason] = strings.Join(record.Reasons, "\n")
+		}
+
+		if metricName != "" {
+			if value, ok := record.Values[metricName]; ok {
+				row[metricColumn] = value
+			} else {
+				row[metricColumn] = nil
+			}
+		}
+
+		tableRecords.Rows = append(tableRecords.Rows, row)
+	}
+
+	return tableRecords
+}
+
+// isPollingTable checks whether the hosts of the table are polled by the long-running commands.
+func isPollingTable(table enums.TableType) bool {
+	for _, pollingTable := range pollingTables {
+		if pollingTable == table {
+			return true
+		}
+	}
+
+	return false
+}
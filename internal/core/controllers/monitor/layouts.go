@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
+)
+
+// parseDashboardLayouts parses the layouts of the dynamic dashboards from the dashboards section of the selected configuration.
+// The dashboards missing in the section are rendered with their built-in layouts.
+func (c *Controller) parseDashboardLayouts() error {
+	aliases := make([]string, 0, len(c.selectedConfig.Dashboards))
+	for alias := range c.selectedConfig.Dashboards {
+		aliases = append(aliases, alias)
+	}
+
+	sort.Strings(aliases)
+
+	layouts := make(map[enums.TableType]dashboards.Layout, len(aliases))
+
+	for _, alias := range aliases {
+		layout, err := dashboards.NewLayout(alias, c.selectedConfig.Dashboards[alias])
+		if err != nil {
+			return fmt.Errorf("invalid layout of the %s dashboard in %s: %w", alias, c.selectedConfig.File, err)
+		}
+
+		layouts[layout.Dashboard] = layout
+	}
+
+	c.layouts = layouts
+
+	return nil
+}
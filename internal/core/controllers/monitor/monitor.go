@@ -1,27 +1,147 @@
 package monitor
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/bartosian/suimon/internal/core/domain/config"
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
 )
 
-const allTablesSelection = "🌐 ALL TABLES"
+const (
+	allTablesSelection = "🌐 ALL TABLES"
+	allTablesAlias     = "all"
+)
+
+var (
+	staticTables = []enums.TableType{
+		enums.TableTypeRPC,
+		enums.TableTypeNode,
+		enums.TableTypeValidator,
+		enums.TableTypeGasPriceAndSubsidy,
+		enums.TableTypeEpochsHistory,
+		enums.TableTypeValidatorsParams,
+		enums.TableTypeValidatorsAtRisk,
+		enums.TableTypeValidatorReports,
+		enums.TableTypeActiveValidators,
+	}
+
+	dynamicDashboards = []enums.TableType{
+		enums.TableTypeNode,
+		enums.TableTypeValidator,
+		enums.TableTypeRPC,
+		enums.TableTypeGasPriceAndSubsidy,
+		enums.TableTypeEpochsHistory,
+		enums.TableTypeActiveValidators,
+		enums.TableTypeFleet,
+	}
+
+	// subscriptionDashboards holds the dynamic dashboards whose counters can be sampled on the events of the host.
+	subscriptionDashboards = map[enums.TableType]bool{
+		enums.TableTypeNode: true,
+		enums.TableTypeRPC:  true,
+	}
+)
 
 // Monitor prompts the user to select the type of monitor to render, and then renders the monitor.
 // For static monitors, the user is prompted to select the tables to render. Only tables that are enabled
 // in the configuration file are displayed in the list of choices. If no tables are enabled, an error is
 // displayed and the function returns without rendering any tables.
-func (c *Controller) Monitor() error {
-	// List available configurations.
+// Every selection provided in the options is used as is and the corresponding prompt is skipped.
+func (c *Controller) Monitor(options ports.MonitorOptions) error {
+	if err := c.selectConfig(options.Network); err != nil {
+		return err
+	}
+
+	monitorType, err := c.selectMonitorType(options)
+	if err != nil {
+		return err
+	}
+
+	if err := c.setOutput(monitorType, options.Output, options.OutFile); err != nil {
+		return err
+	}
+
+	switch monitorType {
+	case enums.MonitorTypeStatic:
+		tablesToRender, err := c.selectStaticTables(options.Tables)
+		if err != nil {
+			return err
+		}
+
+		if tablesToRender == nil {
+			return nil
+		}
+
+		c.selectedTables = tablesToRender
+
+		return c.Static()
+
+	case enums.MonitorTypeDynamic:
+		dashboardToRender, err := c.selectDynamicDashboard(options.Dashboard)
+		if err != nil {
+			return err
+		}
+
+		if dashboardToRender == nil {
+			return nil
+		}
+
+		if options.Subscribe && !subscriptionDashboards[*dashboardToRender] {
+			return fmt.Errorf("subscription is not supported for the %s dashboard, supported dashboards: node, rpc", dashboardToRender.Alias())
+		}
+
+		if options.Host != "" && *dashboardToRender == enums.TableTypeFleet {
+			return errors.New("the host can not be selected for the fleet dashboard, it renders all the full nodes and validators")
+		}
+
+		// the fleet dashboard keeps the hosts which failed to respond, so they are rendered as unhealthy
+		c.longRunning = *dashboardToRender == enums.TableTypeFleet
+
+		c.selectedDashboard = *dashboardToRender
+		c.selectedHost = options.Host
+		c.selectedSubscribe = options.Subscribe
+
+		return c.Dynamic()
+	default:
+		return fmt.Errorf("not supported monitoring type provided %s", monitorType)
+	}
+}
+
+// selectConfig sets the configuration to use. If a network name is provided, the configuration
+// is looked up by name, otherwise the user is prompted to select one of the available configurations.
+func (c *Controller) selectConfig(network string) error {
+	if err := c.loadConfigs(); err != nil {
+		return err
+	}
+
 	configNames := make([]string, 0, len(c.configs))
 
 	for configName := range c.configs {
 		configNames = append(configNames, configName)
 	}
 
+	sort.Strings(configNames)
+
+	if network != "" {
+		configName := strings.ToUpper(strings.TrimSpace(network))
+
+		config, ok := c.configs[configName]
+		if !ok {
+			return fmt.Errorf("no configuration found for network %q, available networks: %s", network, strings.ToLower(strings.Join(configNames, ", ")))
+		}
+
+		c.selectedNetwork = configName
+		c.selectedConfig = config
+
+		return c.checkSelectedConfig()
+	}
+
 	configsChoiceList := cligw.NewSelectChoiceList(configNames...)
 
 	selectedConfigName, err := c.gateways.cli.SelectOne("Which configuration would you like to use?", configsChoiceList)
@@ -31,9 +151,74 @@ func (c *Controller) Monitor() error {
 		return err
 	}
 
+	c.selectedNetwork = selectedConfigName.Value
 	c.selectedConfig = c.configs[selectedConfigName.Value]
 
-	// Select the monitor type.
+	return c.checkSelectedConfig()
+}
+
+// loadConfigs reads the configuration files unless they are already loaded.
+func (c *Controller) loadConfigs() error {
+	if c.configs != nil {
+		return nil
+	}
+
+	configs, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+
+	c.configs = configs
+
+	return nil
+}
+
+// checkSelectedConfig returns an error if problems were found in the selected configuration file.
+func (c *Controller) checkSelectedConfig() error {
+	problems := c.selectedConfig.Problems
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("configuration file %s has %d problem(s), run 'suimon config lint' for details, first one: %s",
+		c.selectedConfig.File, len(problems), problems[0].String())
+}
+
+// selectNetwork sets the configuration to use in the non-interactive modes.
+// If no network name is provided and only one configuration exists, it is used without prompting the user.
+func (c *Controller) selectNetwork(network string) error {
+	if err := c.loadConfigs(); err != nil {
+		return err
+	}
+
+	if network == "" && len(c.configs) == 1 {
+		for configName := range c.configs {
+			network = configName
+		}
+	}
+
+	return c.selectConfig(network)
+}
+
+// selectMonitorType returns the monitor type to render. The type is derived from the options
+// when the static flag, the tables, the dashboard or the subscription are provided, otherwise the user is prompted.
+func (c *Controller) selectMonitorType(options ports.MonitorOptions) (enums.MonitorType, error) {
+	staticSelected := options.Static || len(options.Tables) > 0
+	dynamicSelected := options.Dashboard != "" || options.Subscribe
+
+	switch {
+	case staticSelected && dynamicSelected:
+		return "", errors.New("static tables and dynamic dashboard can not be rendered at the same time")
+	case staticSelected && options.Host != "":
+		return "", errors.New("host selection is supported for dynamic dashboards only")
+	case staticSelected && options.Subscribe:
+		return "", errors.New("subscription is supported for dynamic dashboards only")
+	case staticSelected:
+		return enums.MonitorTypeStatic, nil
+	case dynamicSelected:
+		return enums.MonitorTypeDynamic, nil
+	}
+
 	monitorTypeChoiceList := cligw.NewSelectChoiceList(
 		string(enums.MonitorTypeStatic),
 		string(enums.MonitorTypeDynamic),
@@ -43,59 +228,57 @@ func (c *Controller) Monitor() error {
 	if err != nil {
 		c.gateways.cli.Error("failed to parse user selection")
 
-		return err
+		return "", err
 	}
 
-	switch selectedMonitorType.Value {
-	case string(enums.MonitorTypeStatic):
-		tablesToRender, err := c.selectStaticTables()
-		if err != nil {
-			return err
-		}
-
-		if tablesToRender == nil {
-			return nil
-		}
+	return enums.MonitorType(selectedMonitorType.Value), nil
+}
 
-		c.selectedTables = tablesToRender
+// setOutput sets the output format and the output file for the rendered tables.
+// Structured output formats and output files are supported for static tables only.
+func (c *Controller) setOutput(monitorType enums.MonitorType, format string, path string) error {
+	if format != "" {
+		outputFormat, ok := enums.OutputFormatFromString(format)
+		if !ok {
+			formats := make([]string, 0, len(enums.OutputFormats()))
 
-		return c.Static()
+			for _, outputFormat := range enums.OutputFormats() {
+				formats = append(formats, outputFormat.ToString())
+			}
 
-	case string(enums.MonitorTypeDynamic):
-		dashboardToRender, err := c.selectDynamicDashboard()
-		if err != nil {
-			return err
+			return fmt.Errorf("unsupported output format %q, supported values: %s", format, strings.Join(formats, ", "))
 		}
 
-		if dashboardToRender == nil {
-			return nil
-		}
+		c.output.format = outputFormat
+	}
 
-		c.selectedDashboard = *dashboardToRender
+	c.output.path = path
 
-		return c.Dynamic()
-	default:
-		return fmt.Errorf("not supported monitoring type provided %s", selectedMonitorType.Value)
+	if monitorType != enums.MonitorTypeStatic && (c.output.format != enums.OutputFormatTable || path != "") {
+		return errors.New("output format and output file are supported for static tables only")
 	}
+
+	return nil
 }
 
 // selectStaticTables prompts the user to select the static tables to render.
+// If table names are provided, they are parsed instead of prompting the user.
 // It returns a slice of enums.TableType representing the selected tables,
 // or an error if the user's selection cannot be parsed or no tables are selected.
-func (c *Controller) selectStaticTables() ([]enums.TableType, error) {
+func (c *Controller) selectStaticTables(tableNames []string) ([]enums.TableType, error) {
+	if len(tableNames) > 0 {
+		return parseTableNames(tableNames, staticTables)
+	}
+
 	// Select the tables to render.
-	tableTypeChoiceList := cligw.NewSelectChoiceList(
-		allTablesSelection,
-		string(enums.TableTypeRPC),
-		string(enums.TableTypeNode),
-		string(enums.TableTypeValidator),
-		string(enums.TableTypeGasPriceAndSubsidy),
-		string(enums.TableTypeEpochsHistory),
-		string(enums.TableTypeValidatorsParams),
-		string(enums.TableTypeValidatorsAtRisk),
-		string(enums.TableTypeValidatorReports),
-		string(enums.TableTypeActiveValidators),
-	)
+	tableTypeChoices := make([]string, 0, len(staticTables)+1)
+	tableTypeChoices = append(tableTypeChoices, allTablesSelection)
+
+	for _, table := range staticTables {
+		tableTypeChoices = append(tableTypeChoices, string(table))
+	}
+
+	tableTypeChoiceList := cligw.NewSelectChoiceList(tableTypeChoices...)
 
 	selectedTableTypes, err := c.gateways.cli.SelectMany("Which tables do you want to render?", tableTypeChoiceList)
 	if err != nil {
@@ -114,17 +297,7 @@ func (c *Controller) selectStaticTables() ([]enums.TableType, error) {
 
 	for _, selectedTable := range selectedTableTypes {
 		if selectedTable.Value == allTablesSelection {
-			tablesToRender = append(tablesToRender,
-				enums.TableTypeRPC,
-				enums.TableTypeNode,
-				enums.TableTypeValidator,
-				enums.TableTypeGasPriceAndSubsidy,
-				enums.TableTypeEpochsHistory,
-				enums.TableTypeValidatorsParams,
-				enums.TableTypeValidatorsAtRisk,
-				enums.TableTypeValidatorReports,
-				enums.TableTypeActiveValidators,
-			)
+			tablesToRender = append(tablesToRender[:0], staticTables...)
 
 			break
 		}
@@ -136,16 +309,27 @@ func (c *Controller) selectStaticTables() ([]enums.TableType, error) {
 }
 
 // selectDynamicDashboard prompts the user to select the dynamic dashboard to render.
+// If a dashboard name is provided, it is parsed instead of prompting the user.
 // It returns a slice of enums.TableType representing the selected dashboard,
 // or an error if the user's selection cannot be parsed or no dashboard is selected.
-func (c *Controller) selectDynamicDashboard() (*enums.TableType, error) {
+func (c *Controller) selectDynamicDashboard(dashboardName string) (*enums.TableType, error) {
+	if dashboardName != "" {
+		dashboards, err := parseTableNames([]string{dashboardName}, dynamicDashboards)
+		if err != nil {
+			return nil, err
+		}
+
+		return &dashboards[0], nil
+	}
+
 	// Select the dashboard to render.
-	dashboardTypeChoiceList := cligw.NewSelectChoiceList(
-		string(enums.TableTypeNode),
-		string(enums.TableTypeValidator),
-		string(enums.TableTypeRPC),
-		string(enums.TableTypeGasPriceAndSubsidy),
-	)
+	dashboardTypeChoices := make([]string, 0, len(dynamicDashboards))
+
+	for _, dashboard := range dynamicDashboards {
+		dashboardTypeChoices = append(dashboardTypeChoices, string(dashboard))
+	}
+
+	dashboardTypeChoiceList := cligw.NewSelectChoiceList(dashboardTypeChoices...)
 
 	selectedDashboardType, err := c.gateways.cli.SelectOne("Which dashboard do you want to render?", dashboardTypeChoiceList)
 	if err != nil {
@@ -165,8 +349,48 @@ func (c *Controller) selectDynamicDashboard() (*enums.TableType, error) {
 	return &dashboardType, nil
 }
 
+// parseTableNames converts the short table names provided on the command line into table types.
+// Only the names of the supported tables are accepted, "all" selects every supported table.
+func parseTableNames(tableNames []string, supported []enums.TableType) ([]enums.TableType, error) {
+	supportedNames := make([]string, 0, len(supported)+1)
+	supportedTables := make(map[enums.TableType]bool, len(supported))
+
+	if len(supported) > 1 {
+		supportedNames = append(supportedNames, allTablesAlias)
+	}
+
+	for _, table := range supported {
+		supportedNames = append(supportedNames, table.Alias())
+		supportedTables[table] = true
+	}
+
+	tables := make([]enums.TableType, 0, len(tableNames))
+	selectedTables := make(map[enums.TableType]bool, len(tableNames))
+
+	for _, tableName := range tableNames {
+		if strings.EqualFold(strings.TrimSpace(tableName), allTablesAlias) && len(supported) > 1 {
+			return supported, nil
+		}
+
+		table, ok := enums.TableTypeFromAlias(tableName)
+		if !ok || !supportedTables[table] {
+			return nil, fmt.Errorf("unsupported table %q, supported values: %s", tableName, strings.Join(supportedNames, ", "))
+		}
+
+		if selectedTables[table] {
+			continue
+		}
+
+		selectedTables[table] = true
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
 // selectHostForDashboard selects a host to render a dashboard for, based on the selected dashboard.
-// It prompts the user to select a host from the list of hosts that support the selected dashboard,
+// If a host was provided on the command line, it is looked up among the hosts that support the selected dashboard.
+// Otherwise, it prompts the user to select a host from the list of hosts that support the selected dashboard,
 // and returns the selected host, or an error if the user's selection cannot be parsed or no host is selected.
 func (c *Controller) selectHostForDashboard() (*domainhost.Host, error) {
 	selectedDashboard := c.selectedDashboard
@@ -176,6 +400,10 @@ func (c *Controller) selectHostForDashboard() (*domainhost.Host, error) {
 		return nil, err
 	}
 
+	if c.selectedHost != "" {
+		return findHost(hosts, c.selectedHost, selectedDashboard)
+	}
+
 	if len(hosts) == 0 {
 		return nil, nil
 	}
@@ -217,3 +445,56 @@ func (c *Controller) selectHostForDashboard() (*domainhost.Host, error) {
 
 	return nil, fmt.Errorf("selected host not found")
 }
+
+// findHost looks up the host matching the provided address. The address matches a host
+// if it equals its endpoint address, its host name or IP, optionally followed by one of its ports.
+func findHost(hosts []domainhost.Host, hostAddress string, dashboard enums.TableType) (*domainhost.Host, error) {
+	hostAddress = strings.TrimSuffix(strings.TrimSpace(hostAddress), "/")
+
+	hostAddresses := make([]string, 0, len(hosts))
+
+	for idx := range hosts {
+		host := &hosts[idx]
+
+		if hostMatchesAddress(host, hostAddress) {
+			return host, nil
+		}
+
+		hostAddresses = append(hostAddresses, host.Endpoint.Address)
+	}
+
+	return nil, fmt.Errorf("host %q not found for dashboard %s, available hosts: %s", hostAddress, dashboard, strings.Join(hostAddresses, ", "))
+}
+
+// hostMatchesAddress checks whether the provided address refers to the host.
+func hostMatchesAddress(host *domainhost.Host, hostAddress string) bool {
+	endpoint := host.Endpoint
+
+	if strings.EqualFold(endpoint.Address, hostAddress) {
+		return true
+	}
+
+	candidates := make([]string, 0, 2)
+
+	if endpoint.Host != nil {
+		candidates = append(candidates, *endpoint.Host)
+	}
+
+	if endpoint.IP != nil {
+		candidates = append(candidates, *endpoint.IP)
+	}
+
+	for _, candidate := range candidates {
+		if strings.EqualFold(candidate, hostAddress) {
+			return true
+		}
+
+		for _, port := range host.Ports {
+			if strings.EqualFold(candidate+":"+port, hostAddress) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -1,8 +1,6 @@
 package monitor
 
 import (
-	"errors"
-	"sort"
 	"sync"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
@@ -46,6 +44,10 @@ func (c *Controller) ParseConfigData(monitorType enums.MonitorType) error {
 		}
 	case enums.MonitorTypeDynamic:
 		tablesToParse = []enums.TableType{c.selectedDashboard}
+
+		if c.selectedDashboard == enums.TableTypeFleet {
+			tablesToParse = []enums.TableType{enums.TableTypeNode, enums.TableTypeValidator}
+		}
 	}
 
 	errChan := make(chan error, len(tablesToParse))
@@ -53,7 +55,7 @@ func (c *Controller) ParseConfigData(monitorType enums.MonitorType) error {
 	var wg sync.WaitGroup
 
 	for _, tableType := range tablesToParse {
-		if tableType == enums.TableTypeGasPriceAndSubsidy {
+		if tableType == enums.TableTypeGasPriceAndSubsidy || tableType == enums.TableTypeActiveValidators {
 			continue
 		}
 
@@ -86,18 +88,14 @@ func (c *Controller) ParseConfigData(monitorType enums.MonitorType) error {
 	return nil
 }
 
-// ParseConfigRPC fetches hosts data for the RPC table, sorts the hosts in
-// alphabetical order, and sets their health status.
+// ParseConfigRPC fetches hosts data for the RPC table, selects the reference RPC
+// and sets the health status of the RPC hosts.
 func (c *Controller) ParseConfigRPC() error {
 	if err := c.getHostsData(enums.TableTypeRPC); err != nil {
 		return err
 	}
 
-	if len(c.hosts.rpc) == 0 {
-		return errors.New("no public RPC provided")
-	}
-
-	if err := c.sortHosts(enums.TableTypeRPC); err != nil {
+	if err := c.selectReferenceRPC(); err != nil {
 		return err
 	}
 
@@ -128,68 +126,57 @@ func (c *Controller) getHostsData(table enums.TableType) error {
 	return c.setHostsByTableType(table, hosts)
 }
 
-// sortHosts sorts the active hosts for the specified table type based on their corresponding metric values.
-// The function retrieves the relevant metric for each host, sorts the hosts by their metric values, and updates the CheckerController's internal state accordingly.
-// Returns an error if the specified table type is invalid or if there is an issue sorting the hosts based on their corresponding metric values.
-func (c *Controller) sortHosts(tableType enums.TableType) error {
-
-	if tableType == enums.TableTypeGasPriceAndSubsidy {
-		return nil
-	}
-
+// setHostsHealth retrieves the latest health information for all active hosts and updates the CheckerController's internal state with the new information.
+// The function retrieves health information for each host in parallel and sets the corresponding health status in the internal state.
+// Returns an error if the health information cannot be retrieved from any of the active hosts or if there is an issue updating the CheckerController's internal state.
+func (c *Controller) setHostsHealth(tableType enums.TableType) error {
 	hosts, err := c.getHostsByTableType(tableType)
 	if err != nil {
 		return err
 	}
 
-	if len(hosts) > 1 {
-		sort.Slice(hosts, func(left, right int) bool {
-			return hosts[left].Status > hosts[right].Status
-		})
+	rpcHost := c.referenceRPC()
 
-		sort.SliceStable(hosts, func(left, right int) bool {
-			return hosts[left].Metrics.TotalTransactionsBlocks > hosts[right].Metrics.TotalTransactionsBlocks
-		})
+	for idx := range hosts {
+		if err = hosts[idx].SetHealth(rpcHost); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// setHostsHealth retrieves the latest health information for all active hosts and updates the CheckerController's internal state with the new information.
-// The function retrieves health information for each host in parallel and sets the corresponding health status in the internal state.
-// Returns an error if the health information cannot be retrieved from any of the active hosts or if there is an issue updating the CheckerController's internal state.
-func (c *Controller) setHostsHealth(tableType enums.TableType) error {
-	hosts, err := c.getHostsByTableType(tableType)
-	if err != nil {
-		return err
+// parseNavigationData retrieves the data of the configured full nodes, validators and extended RPC hosts unless they were
+// parsed for the selected dashboard, so the running dashboard can be switched to their dashboards. The errors are not
+// reported, the dashboards which hosts fail to respond are not offered for switching.
+func (c *Controller) parseNavigationData() {
+	configured := map[enums.TableType]bool{
+		enums.TableTypeNode:          len(c.selectedConfig.FullNodes) > 0,
+		enums.TableTypeValidator:     len(c.selectedConfig.Validators) > 0,
+		enums.TableTypeEpochsHistory: len(c.selectedConfig.PublicExtendedRPC) > 0,
 	}
 
-	rpcHost := c.hosts.rpc[0]
-
-	for idx := range hosts {
-		metrics := hosts[idx].Metrics
-
-		checkpointExecBacklog := metrics.HighestKnownCheckpoint - metrics.LastExecutedCheckpoint
-		checkpointSyncBacklog := metrics.HighestKnownCheckpoint - metrics.HighestSyncedCheckpoint
+	var wg sync.WaitGroup
 
-		if err = hosts[idx].SetPctProgress(enums.MetricTypeTxSyncPercentage, rpcHost); err != nil {
-			return err
+	for tableType, ok := range configured {
+		if !ok || len(c.navigationHosts(tableType)) > 0 {
+			continue
 		}
 
-		if err = hosts[idx].SetPctProgress(enums.MetricTypeCheckSyncPercentage, rpcHost); err != nil {
-			return err
-		}
+		wg.Add(1)
 
-		if err = hosts[idx].Metrics.SetValue(enums.MetricTypeCheckpointExecBacklog, checkpointExecBacklog); err != nil {
-			return err
-		}
+		go func(table enums.TableType) {
+			defer wg.Done()
 
-		if err = hosts[idx].Metrics.SetValue(enums.MetricTypeCheckpointSyncBacklog, checkpointSyncBacklog); err != nil {
-			return err
-		}
+			if err := c.getHostsData(table); err != nil {
+				return
+			}
 
-		hosts[idx].SetStatus(rpcHost)
+			if table != enums.TableTypeEpochsHistory && len(c.navigationHosts(table)) > 0 {
+				_ = c.setHostsHealth(table)
+			}
+		}(tableType)
 	}
 
-	return nil
+	wg.Wait()
 }
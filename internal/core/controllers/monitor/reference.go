@@ -0,0 +1,110 @@
+package monitor
+
+import (
+	"errors"
+
+	"github.com/bartosian/suimon/internal/core/domain/host"
+)
+
+// selectReferenceRPC selects the RPC host used as the reference for the sync progress and the health of the other hosts
+// and as the source of the network wide tables. The most advanced RPC among the ones which responded on the last poll is selected,
+// so a stale or failed endpoint does not skew the other hosts, and the next most advanced endpoint takes over as soon as the reference
+// fails to respond. The RPC hosts keep their order, the index of the reference is stored, since the hosts are read concurrently.
+func (c *Controller) selectReferenceRPC() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	hosts := c.hosts.rpc
+	if len(hosts) == 0 {
+		return errors.New("no public RPC provided")
+	}
+
+	var referenceIdx int
+
+	for idx := range hosts {
+		if host.IsMoreAdvanced(hosts[idx], hosts[referenceIdx]) {
+			referenceIdx = idx
+		}
+	}
+
+	for idx := range hosts {
+		hosts[idx].Reference = idx == referenceIdx
+	}
+
+	c.hosts.referenceIdx = referenceIdx
+
+	reference := hosts[referenceIdx]
+	if !reference.Metrics.Updated {
+		return errors.New("none of the public RPC endpoints responded")
+	}
+
+	// the RPC URL is used to tell the reference apart, since the hosts on the same address differ by their ports only
+	referenceURL, err := reference.GetUrlRPC()
+	if err != nil {
+		return err
+	}
+
+	if c.longRunning && c.hosts.reference != referenceURL {
+		if c.hosts.reference == "" {
+			c.gateways.cli.Info("reference rpc", referenceURL)
+		} else {
+			c.gateways.cli.Warnf("reference rpc changed from %s to %s", c.hosts.reference, referenceURL)
+		}
+	}
+
+	c.hosts.reference = referenceURL
+
+	c.trackValidators(reference)
+
+	return nil
+}
+
+// trackValidators marks the validators configured with their Sui address or name in the system state of the reference RPC host,
+// so they are highlighted in the network wide tables.
+func (c *Controller) trackValidators(reference host.Host) {
+	systemState := reference.Metrics.SystemState
+
+	for _, validatorConfig := range c.selectedConfig.Validators {
+		if validatorConfig.SuiAddress == "" && validatorConfig.Name == "" {
+			continue
+		}
+
+		if validator := systemState.LookupValidator(validatorConfig.SuiAddress, validatorConfig.Name); validator != nil {
+			validator.Tracked = true
+		}
+	}
+}
+
+// referenceRPC returns the copy of the RPC host selected as the reference.
+func (c *Controller) referenceRPC() host.Host {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.hosts.rpc[c.hosts.referenceIdx]
+}
+
+// referenceHosts returns the list holding the RPC host selected as the reference, the network wide tables are served by it.
+// The list shares the host with the RPC hosts, so the host is refreshed along with them. The caller has to hold the controller lock.
+func (c *Controller) referenceHosts() []host.Host {
+	if len(c.hosts.rpc) == 0 {
+		return nil
+	}
+
+	return c.hosts.rpc[c.hosts.referenceIdx : c.hosts.referenceIdx+1]
+}
+
+// rankedFallbackRPC returns the copies of the RPC hosts other than the reference ordered by their progress,
+// the network wide dashboards fail over to them. The caller has to hold the controller lock.
+func (c *Controller) rankedFallbackRPC() []host.Host {
+	hosts := make([]host.Host, 0, len(c.hosts.rpc))
+
+	for idx := range c.hosts.rpc {
+		if idx != c.hosts.referenceIdx {
+			hosts = append(hosts, c.hosts.rpc[idx])
+		}
+	}
+
+	host.SortByProgress(hosts)
+
+	return hosts
+}
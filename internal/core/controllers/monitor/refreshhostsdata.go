@@ -0,0 +1,88 @@
+package monitor
+
+import (
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// pollingTables holds the tables whose hosts are polled by the long-running commands.
+var pollingTables = []enums.TableType{
+	enums.TableTypeRPC,
+	enums.TableTypeNode,
+	enums.TableTypeValidator,
+}
+
+// refreshHostsData polls the already created hosts of the specified tables for fresh metrics and recalculates their health.
+// The RPC hosts are always refreshed first and the reference RPC is selected again, since it is used for the health of the other hosts.
+// The hosts which fail to respond are marked as not updated, so they are reported as unhealthy. The errors are aggregated and returned.
+func (c *Controller) refreshHostsData(tables ...enums.TableType) error {
+	var mErr *multierror.Error
+
+	if err := c.refreshTableHosts(enums.TableTypeRPC); err != nil {
+		mErr = multierror.Append(mErr, err)
+	}
+
+	if err := c.selectReferenceRPC(); err != nil {
+		mErr = multierror.Append(mErr, err)
+	}
+
+	for _, table := range tables {
+		if table == enums.TableTypeRPC {
+			if err := c.setHostsHealth(table); err != nil {
+				mErr = multierror.Append(mErr, err)
+			}
+
+			continue
+		}
+
+		if err := c.refreshTableHosts(table); err != nil {
+			mErr = multierror.Append(mErr, err)
+		}
+
+		if err := c.setHostsHealth(table); err != nil {
+			mErr = multierror.Append(mErr, err)
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
+// refreshTableHosts fetches the metrics for all hosts of the specified table in parallel.
+func (c *Controller) refreshTableHosts(table enums.TableType) error {
+	hosts, err := c.getHostsByTableType(table)
+	if err != nil {
+		return err
+	}
+
+	errChan := make(chan error, len(hosts))
+
+	var wg sync.WaitGroup
+
+	for idx := range hosts {
+		wg.Add(1)
+
+		go func(idx int) {
+			defer wg.Done()
+
+			if err := hosts[idx].GetMetrics(); err != nil {
+				hosts[idx].Metrics.Updated = false
+
+				errChan <- err
+			}
+		}(idx)
+	}
+
+	wg.Wait()
+	close(errChan)
+
+	var mErr *multierror.Error
+
+	for err := range errChan {
+		mErr = multierror.Append(mErr, err)
+	}
+
+	return mErr.ErrorOrNil()
+}
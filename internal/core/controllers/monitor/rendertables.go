@@ -4,11 +4,14 @@ import (
 	"fmt"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder"
+	"github.com/bartosian/suimon/internal/core/ports"
 )
 
 // RenderTables renders the selected tables. The function checks whether data has been provided for each table
 // and enables or disables the table based on the availability of data. For each selected table, the function
 // retrieves the corresponding table builder from the static table builders map and calls its Render method.
+// For structured output formats, the records of all selected tables are written to the output at once.
 // The function returns nil if all selected tables have been rendered successfully.
 func (c *Controller) RenderTables() error {
 	selectedTables := c.selectedTables
@@ -30,17 +33,36 @@ func (c *Controller) RenderTables() error {
 		enums.TableTypeActiveValidators:   rpcProvided,
 	}
 
+	records := make([]ports.TableRecords, 0, len(selectedTables))
+
 	for _, tableType := range selectedTables {
 		if !tableTypeEnabled[tableType] {
 			continue
 		}
 
-		builder := c.builders.static[tableType]
+		builder, ok := c.builders.static[tableType]
+		if !ok {
+			continue
+		}
+
+		if c.output.format != enums.OutputFormatTable {
+			records = append(records, builder.Records())
+
+			continue
+		}
 
 		if err := builder.Render(); err != nil {
 			return fmt.Errorf("error rendering table %s: %w", tableType, err)
 		}
 	}
 
+	if c.output.format == enums.OutputFormatTable {
+		return nil
+	}
+
+	if err := tablebuilder.WriteRecords(c.output.writer, c.output.format, records); err != nil {
+		return fmt.Errorf("error writing %s output: %w", c.output.format, err)
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder"
@@ -15,6 +16,14 @@ func (c *Controller) Static() error {
 		return err
 	}
 
+	// Open the output file if requested.
+	closeOutput, err := c.openOutput()
+	if err != nil {
+		return err
+	}
+
+	defer closeOutput()
+
 	// Initialize tables based on the configuration data.
 	if err := c.InitTables(); err != nil {
 		return err
@@ -24,6 +33,29 @@ func (c *Controller) Static() error {
 	return c.RenderTables()
 }
 
+// openOutput creates the output file if one was provided and sets it as the output writer.
+// It returns a function which closes the output file.
+func (c *Controller) openOutput() (func(), error) {
+	if c.output.path == "" {
+		return func() {}, nil
+	}
+
+	file, err := os.Create(c.output.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	c.output.writer = file
+
+	return func() {
+		if err := file.Close(); err != nil {
+			c.gateways.cli.Errorf("failed to close output file: %s", err)
+		}
+
+		c.output.writer = os.Stdout
+	}, nil
+}
+
 // InitTables initializes the enabled tables based on the display configuration.
 // It retrieves the corresponding hosts for each table and initializes the table builder.
 // If an error occurs during table initialization, it returns an error.
@@ -40,7 +72,7 @@ func (c *Controller) InitTables() error {
 			continue
 		}
 
-		builder := tablebuilder.NewBuilder(tableType, hosts, c.gateways.cli)
+		builder := tablebuilder.NewBuilder(tableType, hosts, c.output.writer, c.gateways.cli)
 		c.builders.static[tableType] = builder
 
 		if err = builder.Init(); err != nil {
@@ -0,0 +1,153 @@
+package monitor
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder"
+	"github.com/bartosian/suimon/internal/core/gateways/rpcgw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// ValidatorsDiff prints the changes of the active validators set between the provided epochs.
+// The validators sets of the epochs are requested from the public extended RPC of the selected configuration.
+func (c *Controller) ValidatorsDiff(options ports.ValidatorsDiffOptions) error {
+	if options.FromEpoch < 0 {
+		return fmt.Errorf("invalid from epoch provided: %d", options.FromEpoch)
+	}
+
+	if options.ToEpoch >= 0 && options.ToEpoch <= options.FromEpoch {
+		return fmt.Errorf("invalid to epoch provided: %d, must be greater than the from epoch %d", options.ToEpoch, options.FromEpoch)
+	}
+
+	format, ok := enums.OutputFormatFromString(options.Output)
+	if !ok {
+		return fmt.Errorf("unsupported output format %q, use one of: %v", options.Output, enums.OutputFormats())
+	}
+
+	if err := c.selectNetwork(options.Network); err != nil {
+		return err
+	}
+
+	rpcHost, current, err := c.epochRPC(-1)
+	if err != nil {
+		return err
+	}
+
+	from, err := rpcHost.GetEpoch(options.FromEpoch)
+	if err != nil {
+		return err
+	}
+
+	to := current
+	if options.ToEpoch >= 0 {
+		if to, err = rpcHost.GetEpoch(options.ToEpoch); err != nil {
+			return err
+		}
+	}
+
+	if from.Epoch == to.Epoch {
+		return fmt.Errorf("epoch %s is the current epoch, nothing to compare it with", from.Epoch)
+	}
+
+	diff, err := metrics.NewValidatorsDiff(from, to)
+	if err != nil {
+		return err
+	}
+
+	if len(diff.Changes) == 0 {
+		c.gateways.cli.Info("validators set", fmt.Sprintf("no changes between epochs %s and %s", diff.FromEpoch, diff.ToEpoch))
+
+		return nil
+	}
+
+	return tablebuilder.WriteRecords(c.output.writer, format, []ports.TableRecords{validatorsDiffTableRecords(diff, format)})
+}
+
+// epochRPC returns the first public extended RPC host responding with the info of the specified epoch, along with the info.
+// The current epoch is requested if the epoch is negative. The hosts are tried in the order of the configuration with a single
+// request each, so the epochs history is not downloaded.
+func (c *Controller) epochRPC(epoch int) (*host.Host, *metrics.EpochInfo, error) {
+	addresses, err := c.getAddressInfoByTableType(enums.TableTypeEpochsHistory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mErr *multierror.Error
+
+	for _, addressInfo := range addresses {
+		rpcURL, err := addressInfo.GetUrlRPC()
+		if err != nil {
+			mErr = multierror.Append(mErr, err)
+
+			continue
+		}
+
+		rpcGateway, err := rpcgw.NewGateway(c.gateways.cli, rpcURL, addressInfo.Connection)
+		if err != nil {
+			mErr = multierror.Append(mErr, err)
+
+			continue
+		}
+
+		rpcHost := host.NewHost(enums.TableTypeEpochsHistory, addressInfo, rpcGateway, nil, nil, c.gateways.cli)
+
+		epochInfo, err := rpcHost.GetEpoch(epoch)
+		if err != nil {
+			mErr = multierror.Append(mErr, err)
+
+			continue
+		}
+
+		return rpcHost, epochInfo, nil
+	}
+
+	return nil, nil, fmt.Errorf("none of the public extended RPC endpoints responded: %w", mErr.ErrorOrNil())
+}
+
+// validatorsDiffTableRecords converts the validators diff to the table records, one record per change.
+// The values missing in the epoch the validator was not active in are left empty.
+func validatorsDiffTableRecords(diff *metrics.ValidatorsDiff, format enums.OutputFormat) ports.TableRecords {
+	tableRecords := ports.TableRecords{
+		Table: enums.TableTypeValidatorsDiff,
+		Columns: []enums.ColumnName{
+			enums.ColumnNameValidatorsDiffChange,
+			enums.ColumnNameValidatorName,
+			enums.ColumnNameAddress,
+			enums.ColumnNameValidatorsDiffFromEpoch,
+			enums.ColumnNameValidatorsDiffToEpoch,
+			enums.ColumnNameValidatorsDiffDelta,
+		},
+		Rows: make([]ports.TableRecord, 0, len(diff.Changes)),
+	}
+
+	for _, change := range diff.Changes {
+		row := ports.TableRecord{
+			enums.ColumnNameValidatorsDiffChange:    string(change.Type),
+			enums.ColumnNameValidatorName:           change.Name,
+			enums.ColumnNameAddress:                 change.SuiAddress,
+			enums.ColumnNameValidatorsDiffFromEpoch: change.Previous,
+			enums.ColumnNameValidatorsDiffToEpoch:   change.Current,
+			enums.ColumnNameValidatorsDiffDelta:     change.Delta(),
+		}
+
+		switch change.Type {
+		case metrics.ValidatorChangeJoined:
+			row[enums.ColumnNameValidatorsDiffFromEpoch] = nil
+		case metrics.ValidatorChangeRemoved:
+			row[enums.ColumnNameValidatorsDiffToEpoch] = nil
+		}
+
+		if format == enums.OutputFormatTable {
+			row[enums.ColumnNameValidatorsDiffDelta] = fmt.Sprintf("%+d", change.Delta())
+		}
+
+		tableRecords.Rows = append(tableRecords.Rows, row)
+	}
+
+	return tableRecords
+}
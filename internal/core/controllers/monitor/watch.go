@@ -0,0 +1,82 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// Watch polls the hosts of the selected configuration on the provided interval and evaluates the alert rules
+// from the alerts section of the configuration against them, reporting the alerts as they fire and resolve.
+// The reports and the at risk records of the tracked validators are watched as well.
+// Every poll is recorded to the history store, unless it is disabled. The function blocks until the process is interrupted.
+func (c *Controller) Watch(options ports.WatchOptions) error {
+	if options.Interval <= 0 {
+		return fmt.Errorf("invalid polling interval provided: %s", options.Interval)
+	}
+
+	if err := c.selectNetwork(options.Network); err != nil {
+		return err
+	}
+
+	engine, err := c.newAlertEngine()
+	if err != nil {
+		return err
+	}
+
+	watcher := c.newValidatorWatcher()
+
+	if err := c.initNotifiers(); err != nil {
+		return err
+	}
+
+	if err := c.initHistory(); err != nil {
+		return err
+	}
+
+	if len(engine.Rules()) == 0 && watcher.Validators() == 0 && c.gateways.history == nil {
+		return errors.New("no alert rules provided in the alerts section of the configuration, no validators tracked and the history is disabled")
+	}
+
+	c.longRunning = true
+	c.selectedTables = pollingTables
+
+	if err := c.ParseConfigData(enums.MonitorTypeStatic); err != nil {
+		return err
+	}
+
+	c.gateways.cli.Info("watching network", fmt.Sprintf("%s, %d alert rules, %d tracked validators, polling every %s",
+		c.selectedNetwork, len(engine.Rules()), watcher.Validators(), options.Interval))
+
+	c.checkEpochChange()
+	c.evaluateAlerts(engine, watcher)
+	c.recordHistory()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := c.refreshHostsData(pollingTables...); err != nil {
+				c.gateways.cli.Errorf("failed to refresh hosts data: %s", err)
+			}
+
+			c.checkEpochChange()
+			c.evaluateAlerts(engine, watcher)
+			c.recordHistory()
+		}
+	}
+}
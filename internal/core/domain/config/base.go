@@ -2,10 +2,10 @@ package config
 
 import (
 	"fmt"
-	"gopkg.in/yaml.v3"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 const (
@@ -15,19 +15,114 @@ const (
 	yamlPattern        = "suimon-*.yaml"
 )
 
-type Config struct {
-	PublicExtendedRPC []string `yaml:"public-extended-rpc"`
-	PublicRPC         []string `yaml:"public-rpc"`
-	FullNodes         []struct {
-		JSONRPCAddress string `yaml:"json-rpc-address"`
-		MetricsAddress string `yaml:"metrics-address"`
-	} `yaml:"full-nodes"`
-	Validators []struct {
+type (
+	// Alerts holds the alert rules evaluated while the hosts are polled by the long-running commands.
+	// The slashing percentage is the threshold the reports on the tracked validators are alerted on.
+	Alerts struct {
+		RepeatInterval     time.Duration `yaml:"repeat-interval"`
+		SlashingPercentage *float64      `yaml:"slashing-percentage"`
+		Rules              []AlertRule   `yaml:"rules"`
+	}
+
+	// AlertRule holds a single alert rule. The condition has the format "<metric> <operator> <value> [for <duration>]",
+	// e.g. "checkpoint-sync-backlog > 500 for 2m" or "status == red".
+	AlertRule struct {
+		Name           string         `yaml:"name"`
+		Condition      string         `yaml:"condition"`
+		Tables         []string       `yaml:"tables"`
+		RepeatInterval *time.Duration `yaml:"repeat-interval"`
+	}
+
+	// History holds the settings of the local store the polled hosts metrics are recorded to.
+	// The store is enabled by default and keeps the data under the data directory next to the configuration files.
+	History struct {
+		Enabled   *bool         `yaml:"enabled"`
+		Path      string        `yaml:"path"`
+		Retention time.Duration `yaml:"retention"`
+	}
+
+	// DashboardLayout holds the layout replacing the built-in layout of a dynamic dashboard: the rows from top to bottom
+	// with their cells from left to right. The heights of the rows are the percentages of the dashboard height and
+	// the widths of the cells are the percentages of the row width.
+	DashboardLayout struct {
+		Rows []DashboardRow `yaml:"rows"`
+	}
+
+	// DashboardRow holds a single row of the dashboard layout.
+	DashboardRow struct {
+		Height int             `yaml:"height"`
+		Cells  []DashboardCell `yaml:"cells"`
+	}
+
+	// DashboardCell holds a single cell of the dashboard layout rendering the column, e.g. network-peers.
+	// The widget type, the title and the color of the built-in cell of the column are used unless they are provided.
+	DashboardCell struct {
+		Column string `yaml:"column"`
+		Width  int    `yaml:"width"`
+		Widget string `yaml:"widget,omitempty"`
+		Title  string `yaml:"title,omitempty"`
+		Color  string `yaml:"color,omitempty"`
+	}
+
+	// FullNode holds the addresses of a full node to monitor, at least one of them has to be provided.
+	// The connection settings apply to both addresses.
+	FullNode struct {
+		JSONRPCAddress string `yaml:"json-rpc-address,omitempty"`
+		MetricsAddress string `yaml:"metrics-address,omitempty"`
+		Connection     `yaml:",inline"`
+		Health         Health `yaml:"health,omitempty"`
+	}
+
+	// Validator holds the address of a validator to monitor.
+	// The Sui address or the name of the validator, when provided, is used to look the validator up in the system state.
+	Validator struct {
 		MetricsAddress string `yaml:"metrics-address"`
-	} `yaml:"validators"`
+		SuiAddress     string `yaml:"sui-address,omitempty"`
+		Name           string `yaml:"name,omitempty"`
+		Connection     `yaml:",inline"`
+		Health         Health `yaml:"health,omitempty"`
+	}
+
+	// IPLookup holds the settings of the https://ipinfo.io/ API used to look up the provider and country of the hosts.
 	IPLookup struct {
 		AccessToken string `yaml:"access-token"`
-	} `yaml:"ip-lookup"`
+	}
+
+	// Notifier holds the settings of a single notification sink the alerts are delivered to.
+	// The URL is used by the webhook, slack and discord notifiers, the token and chat ID by the telegram notifier.
+	// The URL, the token and the chat ID can reference the environment variables in the ${NAME} form,
+	// so the secrets do not have to be kept in the configuration file.
+	Notifier struct {
+		Type     string `yaml:"type"`
+		URL      string `yaml:"url"`
+		Token    string `yaml:"token"`
+		ChatID   string `yaml:"chat-id"`
+		Template string `yaml:"template"`
+	}
+)
+
+type Config struct {
+	PublicExtendedRPC []RPCEndpoint `yaml:"public-extended-rpc"`
+	PublicRPC         []RPCEndpoint `yaml:"public-rpc"`
+	FullNodes         []FullNode    `yaml:"full-nodes"`
+	Validators        []Validator   `yaml:"validators"`
+	Connection        Connection    `yaml:"connection,omitempty"`
+	Health            Health        `yaml:"health,omitempty"`
+	IPLookup          IPLookup      `yaml:"ip-lookup,omitempty"`
+	Alerts            Alerts        `yaml:"alerts,omitempty"`
+	Notifiers         []Notifier    `yaml:"notifiers,omitempty"`
+	History           History       `yaml:"history,omitempty"`
+
+	// Dashboards holds the layouts of the dynamic dashboards keyed by their names, e.g. node.
+	Dashboards map[string]DashboardLayout `yaml:"dashboards,omitempty"`
+
+	// File is the path of the configuration file and Problems holds the problems found in it.
+	// The configuration can not be used until the problems are fixed.
+	File     string   `yaml:"-"`
+	Problems Problems `yaml:"-"`
+
+	// lines holds the line numbers of the configuration entries keyed by their paths, e.g. full-nodes[0].metrics-address.
+	lines map[string]int
 }
 
 // NewConfig reads the Suimon configuration files from the directory specified by
@@ -35,51 +130,88 @@ type Config struct {
 // environment variable is not set, and returns a map of Config objects with the
 // file name segments as the keys.
 func NewConfig() (map[string]Config, error) {
-	dirPath := os.Getenv(suimonConfigEnvVar)
-	if dirPath == "" {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return nil, err
-		}
-
-		dirPath = filepath.Join(homeDir, suimonConfigDir)
+	dirPath, err := DirPath()
+	if err != nil {
+		return nil, err
 	}
 
 	return readConfigs(dirPath)
 }
 
-// readConfigs reads the Suimon configuration files from the specified directory,
-// creates a map of Config objects with the file name segments as the keys, and returns
-// the map. The file name segments are converted to uppercase before being used as keys.
-func readConfigs(dirPath string) (map[string]Config, error) {
-	configs := make(map[string]Config)
+// DirPath returns the path of the directory the Suimon configuration files are read from,
+// which is the SUIMON_CONFIG_PATH environment variable or the default directory if it is not set.
+func DirPath() (string, error) {
+	if dirPath := os.Getenv(suimonConfigEnvVar); dirPath != "" {
+		return dirPath, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
 
+	return filepath.Join(homeDir, suimonConfigDir), nil
+}
+
+// Files returns the paths of the Suimon configuration files in the specified directory.
+func Files(dirPath string) ([]string, error) {
 	ymlFiles, _ := filepath.Glob(filepath.Join(dirPath, ymlPattern))
 	yamlFiles, _ := filepath.Glob(filepath.Join(dirPath, yamlPattern))
 
-	if len(ymlFiles)+len(yamlFiles) == 0 {
+	files := append(ymlFiles, yamlFiles...)
+	if len(files) == 0 {
 		return nil, fmt.Errorf("no suimon configuration files found in %s", dirPath)
 	}
 
-	for _, file := range append(ymlFiles, yamlFiles...) {
-		fileData, err := os.ReadFile(file)
-		if err != nil {
-			return nil, err
-		}
+	return files, nil
+}
+
+// ReadConfigFile reads the Suimon configuration file, decodes it strictly and validates its entries.
+// The problems found in the file are kept in the Problems field of the returned Config,
+// the error is returned only if the file can not be read.
+func ReadConfigFile(file string) (Config, error) {
+	fileData, err := os.ReadFile(file)
+	if err != nil {
+		return Config{}, err
+	}
 
-		var config Config
-		err = yaml.Unmarshal(fileData, &config)
+	config, ok := decodeConfig(file, fileData)
+	if ok {
+		config.Problems = append(config.Problems, config.validate()...)
+	}
+
+	return config, nil
+}
+
+// NetworkName returns the name of the network the configuration file is used for,
+// which is the file name segment after the "suimon-" prefix converted to uppercase.
+func NetworkName(file string) string {
+	filename := filepath.Base(file)
+	filename = strings.TrimPrefix(filename, "suimon-")
+	filename = strings.TrimSuffix(filename, ".yml")
+	filename = strings.TrimSuffix(filename, ".yaml")
+
+	return strings.ToUpper(filename)
+}
+
+// readConfigs reads the Suimon configuration files from the specified directory,
+// creates a map of Config objects with the file name segments as the keys, and returns
+// the map. The file name segments are converted to uppercase before being used as keys.
+func readConfigs(dirPath string) (map[string]Config, error) {
+	files, err := Files(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make(map[string]Config, len(files))
+
+	for _, file := range files {
+		config, err := ReadConfigFile(file)
 		if err != nil {
 			return nil, err
 		}
 
-		filename := filepath.Base(file)
-		filename = strings.TrimPrefix(filename, "suimon-")
-		filename = strings.TrimSuffix(filename, ".yml")
-		filename = strings.TrimSuffix(filename, ".yaml")
-		filename = strings.ToUpper(filename)
-
-		configs[filename] = config
+		configs[NetworkName(file)] = config
 	}
 
 	return configs, nil
@@ -0,0 +1,374 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/bartosian/suimon/internal/pkg/httpclient"
+)
+
+const (
+	// DefaultTimeout is the timeout of a single request to the monitored endpoint.
+	DefaultTimeout = 3 * time.Second
+	// DefaultRetries is the number of times a request failed with a transient error is retried.
+	DefaultRetries = 2
+	// DefaultBackoff is the base delay before the first retry, it is doubled on every next retry.
+	DefaultBackoff = 500 * time.Millisecond
+)
+
+// envReferencePattern matches the references to the environment variables, e.g. ${SUI_RPC_TOKEN}.
+var envReferencePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+type (
+	// Connection holds the settings of the requests sent to the monitored endpoints. The settings of the connection
+	// section apply to all endpoints and can be overridden in the full-nodes, validators and RPC entries.
+	//
+	// The values of the headers, basic-auth and bearer-token settings can reference the environment variables
+	// in the ${NAME} form, so the secrets do not have to be kept in the configuration file.
+	Connection struct {
+		Timeout            time.Duration     `yaml:"timeout,omitempty"`
+		Retries            *int              `yaml:"retries,omitempty"`
+		Backoff            time.Duration     `yaml:"backoff,omitempty"`
+		Headers            map[string]string `yaml:"headers,omitempty"`
+		BasicAuth          *BasicAuth        `yaml:"basic-auth,omitempty"`
+		BearerToken        string            `yaml:"bearer-token,omitempty"`
+		CAFile             string            `yaml:"ca-file,omitempty"`
+		ClientCert         string            `yaml:"client-cert,omitempty"`
+		ClientKey          string            `yaml:"client-key,omitempty"`
+		InsecureSkipVerify *bool             `yaml:"insecure-skip-verify,omitempty"`
+	}
+
+	// BasicAuth holds the credentials of the HTTP basic authentication.
+	BasicAuth struct {
+		Username string `yaml:"username"`
+		Password string `yaml:"password"`
+	}
+
+	// RPCEndpoint holds a public RPC entry, which is either a plain address or a mapping
+	// with the address, the connection settings and the health thresholds of the endpoint.
+	RPCEndpoint struct {
+		Address    string `yaml:"address"`
+		Connection `yaml:",inline"`
+		Health     Health `yaml:"health,omitempty"`
+
+		// unknownFields holds the decoding errors of the fields of the mapping not defined in the entry.
+		unknownFields []string
+	}
+
+	// rpcEndpoint is used to decode and encode the mapping form of RPCEndpoint without recursion.
+	rpcEndpoint RPCEndpoint
+)
+
+// NewRPCEndpoints returns the RPC entries for the plain addresses.
+func NewRPCEndpoints(addresses ...string) []RPCEndpoint {
+	endpoints := make([]RPCEndpoint, 0, len(addresses))
+
+	for _, addr := range addresses {
+		endpoints = append(endpoints, RPCEndpoint{Address: addr})
+	}
+
+	return endpoints
+}
+
+// RPCAddresses returns the addresses of the RPC entries.
+func RPCAddresses(endpoints []RPCEndpoint) []string {
+	addresses := make([]string, 0, len(endpoints))
+
+	for _, endpoint := range endpoints {
+		addresses = append(addresses, endpoint.Address)
+	}
+
+	return addresses
+}
+
+// Merge returns the connection settings with the settings not set taken from the defaults.
+func (connection Connection) Merge(defaults Connection) Connection {
+	if connection.Timeout == 0 {
+		connection.Timeout = defaults.Timeout
+	}
+
+	if connection.Retries == nil {
+		connection.Retries = defaults.Retries
+	}
+
+	if connection.Backoff == 0 {
+		connection.Backoff = defaults.Backoff
+	}
+
+	if len(defaults.Headers) > 0 {
+		headers := make(map[string]string, len(defaults.Headers)+len(connection.Headers))
+
+		for name, value := range defaults.Headers {
+			headers[name] = value
+		}
+
+		for name, value := range connection.Headers {
+			headers[name] = value
+		}
+
+		connection.Headers = headers
+	}
+
+	if connection.BasicAuth == nil && connection.BearerToken == "" {
+		connection.BasicAuth, connection.BearerToken = defaults.BasicAuth, defaults.BearerToken
+	}
+
+	if connection.CAFile == "" {
+		connection.CAFile = defaults.CAFile
+	}
+
+	if connection.ClientCert == "" && connection.ClientKey == "" {
+		connection.ClientCert, connection.ClientKey = defaults.ClientCert, defaults.ClientKey
+	}
+
+	if connection.InsecureSkipVerify == nil {
+		connection.InsecureSkipVerify = defaults.InsecureSkipVerify
+	}
+
+	return connection
+}
+
+// GetTimeout returns the timeout of a single request, or the default one if it is not set.
+func (connection Connection) GetTimeout() time.Duration {
+	if connection.Timeout <= 0 {
+		return DefaultTimeout
+	}
+
+	return connection.Timeout
+}
+
+// GetRetries returns the number of retries, or the default one if it is not set.
+func (connection Connection) GetRetries() int {
+	if connection.Retries == nil || *connection.Retries < 0 {
+		return DefaultRetries
+	}
+
+	return *connection.Retries
+}
+
+// GetBackoff returns the base delay before the first retry, or the default one if it is not set.
+func (connection Connection) GetBackoff() time.Duration {
+	if connection.Backoff <= 0 {
+		return DefaultBackoff
+	}
+
+	return connection.Backoff
+}
+
+// ClientOptions returns the options of the HTTP client requesting the endpoint with the connection settings.
+// It returns an error if a referenced environment variable is not set or the certificates can not be loaded.
+func (connection Connection) ClientOptions() (httpclient.Options, error) {
+	options := httpclient.Options{
+		Timeout: connection.GetTimeout(),
+		Headers: make(http.Header),
+	}
+
+	for name, value := range connection.Headers {
+		value, err := expandEnv(value)
+		if err != nil {
+			return options, fmt.Errorf("header %s: %w", name, err)
+		}
+
+		options.Headers.Set(name, value)
+	}
+
+	switch {
+	case connection.BasicAuth != nil && connection.BearerToken != "":
+		return options, errors.New("basic-auth and bearer-token can not be used together")
+	case connection.BasicAuth != nil:
+		username, err := expandEnv(connection.BasicAuth.Username)
+		if err != nil {
+			return options, fmt.Errorf("basic-auth username: %w", err)
+		}
+
+		if username == "" {
+			return options, errors.New("basic-auth username is required")
+		}
+
+		password, err := expandEnv(connection.BasicAuth.Password)
+		if err != nil {
+			return options, fmt.Errorf("basic-auth password: %w", err)
+		}
+
+		credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+		options.Headers.Set("Authorization", "Basic "+credentials)
+	case connection.BearerToken != "":
+		token, err := expandEnv(connection.BearerToken)
+		if err != nil {
+			return options, fmt.Errorf("bearer-token: %w", err)
+		}
+
+		options.Headers.Set("Authorization", "Bearer "+token)
+	}
+
+	tlsConfig, err := connection.tlsConfig()
+	if err != nil {
+		return options, err
+	}
+
+	options.TLS = tlsConfig
+
+	return options, nil
+}
+
+// tlsConfig returns the TLS settings of the connection, or nil if the default ones are used.
+func (connection Connection) tlsConfig() (*tls.Config, error) {
+	insecureSkipVerify := connection.InsecureSkipVerify != nil && *connection.InsecureSkipVerify
+
+	if connection.CAFile == "" && connection.ClientCert == "" && connection.ClientKey == "" && !insecureSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify, //nolint:gosec // explicitly requested in the configuration
+	}
+
+	if connection.CAFile != "" {
+		caData, err := os.ReadFile(connection.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca-file: %w", err)
+		}
+
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if !rootCAs.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("ca-file %s does not contain any PEM encoded certificates", connection.CAFile)
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if connection.ClientCert != "" || connection.ClientKey != "" {
+		if connection.ClientCert == "" || connection.ClientKey == "" {
+			return nil, errors.New("client-cert and client-key must be provided together")
+		}
+
+		certificate, err := tls.LoadX509KeyPair(connection.ClientCert, connection.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client-cert and client-key: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// expandEnv replaces the references to the environment variables in the value with their values.
+// It returns an error if a referenced environment variable is not set.
+func expandEnv(value string) (string, error) {
+	var err error
+
+	expanded := envReferencePattern.ReplaceAllStringFunc(value, func(reference string) string {
+		name := envReferencePattern.FindStringSubmatch(reference)[1]
+
+		envValue, ok := os.LookupEnv(name)
+		if !ok && err == nil {
+			err = fmt.Errorf("environment variable %s is not set", name)
+		}
+
+		return envValue
+	})
+
+	return expanded, err
+}
+
+// UnmarshalYAML decodes the RPC entry from either a plain address or a mapping. The unknown fields of the mapping
+// are kept to be reported by the validation, since the strict decoding does not apply to the custom unmarshalers.
+func (endpoint *RPCEndpoint) UnmarshalYAML(value *yaml.Node) error {
+	*endpoint = RPCEndpoint{}
+
+	if value.Kind == yaml.ScalarNode {
+		return value.Decode(&endpoint.Address)
+	}
+
+	if err := value.Decode((*rpcEndpoint)(endpoint)); err != nil {
+		return err
+	}
+
+	if value.Kind == yaml.MappingNode {
+		endpoint.unknownFields = unknownFields(value, reflect.TypeOf(rpcEndpoint{}), "config.RPCEndpoint")
+
+		for idx := 0; idx+1 < len(value.Content); idx += 2 {
+			if key, field := value.Content[idx], value.Content[idx+1]; key.Value == "health" && field.Kind == yaml.MappingNode {
+				endpoint.unknownFields = append(endpoint.unknownFields, unknownFields(field, reflect.TypeOf(Health{}), "config.Health")...)
+			}
+		}
+	}
+
+	return nil
+}
+
+// unknownFields returns the decoding errors of the keys of the mapping not defined in the struct type.
+func unknownFields(mapping *yaml.Node, structType reflect.Type, typeName string) []string {
+	var messages []string
+
+	knownFields := yamlFields(structType)
+
+	for idx := 0; idx+1 < len(mapping.Content); idx += 2 {
+		key := mapping.Content[idx]
+
+		if _, ok := knownFields[key.Value]; !ok {
+			messages = append(messages, fmt.Sprintf("line %d: field %s not found in type %s", key.Line, key.Value, typeName))
+		}
+	}
+
+	return messages
+}
+
+// MarshalYAML encodes the RPC entry as a plain address if no connection settings and health thresholds are set for it.
+func (endpoint RPCEndpoint) MarshalYAML() (any, error) {
+	if reflect.ValueOf(endpoint.Connection).IsZero() && reflect.ValueOf(endpoint.Health).IsZero() {
+		return endpoint.Address, nil
+	}
+
+	return rpcEndpoint{Address: endpoint.Address, Connection: endpoint.Connection, Health: endpoint.Health}, nil
+}
+
+// yamlFields returns the names of the YAML fields of the struct type, including the fields of the inlined structs.
+func yamlFields(structType reflect.Type) map[string]struct{} {
+	fields := make(map[string]struct{})
+
+	for idx := 0; idx < structType.NumField(); idx++ {
+		field := structType.Field(idx)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if options == "inline" && field.Type.Kind() == reflect.Struct {
+			for inlineName := range yamlFields(field.Type) {
+				fields[inlineName] = struct{}{}
+			}
+
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		fields[name] = struct{}{}
+	}
+
+	return fields
+}
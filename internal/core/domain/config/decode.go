@@ -0,0 +1,134 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// lineErrorPattern matches the decoding errors reported for a specific line, e.g. "line 3: field foo not found in type config.Config".
+	lineErrorPattern = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+	// unknownFieldPattern matches the errors reported for the fields not defined in the configuration.
+	unknownFieldPattern = regexp.MustCompile(`^field (\S+) not found in type .+$`)
+)
+
+// decodeConfig decodes the configuration file data. Unknown fields are reported as problems,
+// along with the syntax and type errors, and the line numbers of all entries are collected.
+// It returns false if the file is not a valid YAML document, so its entries can not be validated.
+func decodeConfig(file string, data []byte) (Config, bool) {
+	config := Config{
+		File:  file,
+		lines: make(map[string]int),
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		config.Problems = append(config.Problems, newDecodeProblem(file, err.Error()))
+
+		return config, false
+	}
+
+	collectLines(&root, "", config.lines)
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(&config)
+
+	var typeErr *yaml.TypeError
+
+	switch {
+	case err == nil, errors.Is(err, io.EOF):
+	case errors.As(err, &typeErr):
+		for _, message := range typeErr.Errors {
+			config.Problems = append(config.Problems, newDecodeProblem(file, message))
+		}
+	default:
+		config.Problems = append(config.Problems, newDecodeProblem(file, err.Error()))
+	}
+
+	return config, true
+}
+
+// newDecodeProblem creates the problem from the decoding error message, extracting the line number from it.
+func newDecodeProblem(file, message string) Problem {
+	problem := Problem{File: file, Message: message}
+
+	if matches := lineErrorPattern.FindStringSubmatch(message); matches != nil {
+		problem.Line, _ = strconv.Atoi(matches[1])
+		problem.Message = matches[2]
+	}
+
+	if matches := unknownFieldPattern.FindStringSubmatch(problem.Message); matches != nil {
+		problem.Message = fmt.Sprintf("unknown field %q", matches[1])
+	}
+
+	return problem
+}
+
+// collectLines walks the YAML node tree and collects the line numbers of the entries keyed by their paths.
+func collectLines(node *yaml.Node, path string, lines map[string]int) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			collectLines(child, path, lines)
+		}
+	case yaml.MappingNode:
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			key, value := node.Content[idx], node.Content[idx+1]
+
+			keyPath := key.Value
+			if path != "" {
+				keyPath = path + "." + key.Value
+			}
+
+			lines[keyPath] = key.Line
+
+			collectLines(value, keyPath, lines)
+		}
+	case yaml.SequenceNode:
+		for idx, item := range node.Content {
+			itemPath := fmt.Sprintf("%s[%d]", path, idx)
+
+			lines[itemPath] = item.Line
+
+			collectLines(item, itemPath, lines)
+		}
+	}
+}
+
+// Line returns the line number of the configuration entry with the specified path, e.g. alerts.rules[0].
+// The line of the closest parent entry is returned if the entry is not present in the file.
+func (config *Config) Line(path string) int {
+	for path != "" {
+		if line, ok := config.lines[path]; ok {
+			return line
+		}
+
+		idx := lastSeparator(path)
+		if idx < 0 {
+			break
+		}
+
+		path = path[:idx]
+	}
+
+	return 0
+}
+
+// lastSeparator returns the index of the last path separator, which is either a dot or an opening bracket.
+func lastSeparator(path string) int {
+	for idx := len(path) - 1; idx >= 0; idx-- {
+		if path[idx] == '.' || path[idx] == '[' {
+			return idx
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+// Health holds the thresholds the health of the hosts is calculated with. The thresholds of the health section
+// apply to all hosts of the network and can be overridden in the health section of the full-nodes, validators and
+// RPC entries. The built-in defaults are used for the thresholds not set.
+type Health struct {
+	TransactionsPerSecondLag   *int `yaml:"transactions-per-second-lag,omitempty"`
+	CheckpointsPerSecondLag    *int `yaml:"checkpoints-per-second-lag,omitempty"`
+	LatestCheckpointLag        *int `yaml:"latest-checkpoint-lag,omitempty"`
+	HighestSyncedCheckpointLag *int `yaml:"highest-synced-checkpoint-lag,omitempty"`
+	TxSyncPercentage           *int `yaml:"tx-sync-percentage,omitempty"`
+	CheckpointSyncPercentage   *int `yaml:"checkpoint-sync-percentage,omitempty"`
+	MaxSyncPercentage          *int `yaml:"max-sync-percentage,omitempty"`
+	RoundLag                   *int `yaml:"round-lag,omitempty"`
+	SignatureErrorsGrowth      *int `yaml:"signature-errors-growth,omitempty"`
+	SkippedConsensusGrowth     *int `yaml:"skipped-consensus-growth,omitempty"`
+}
+
+// Merge returns the health thresholds with the thresholds not set taken from the defaults.
+func (health Health) Merge(defaults Health) Health {
+	mergeThreshold := func(threshold, defaultThreshold *int) *int {
+		if threshold == nil {
+			return defaultThreshold
+		}
+
+		return threshold
+	}
+
+	return Health{
+		TransactionsPerSecondLag:   mergeThreshold(health.TransactionsPerSecondLag, defaults.TransactionsPerSecondLag),
+		CheckpointsPerSecondLag:    mergeThreshold(health.CheckpointsPerSecondLag, defaults.CheckpointsPerSecondLag),
+		LatestCheckpointLag:        mergeThreshold(health.LatestCheckpointLag, defaults.LatestCheckpointLag),
+		HighestSyncedCheckpointLag: mergeThreshold(health.HighestSyncedCheckpointLag, defaults.HighestSyncedCheckpointLag),
+		TxSyncPercentage:           mergeThreshold(health.TxSyncPercentage, defaults.TxSyncPercentage),
+		CheckpointSyncPercentage:   mergeThreshold(health.CheckpointSyncPercentage, defaults.CheckpointSyncPercentage),
+		MaxSyncPercentage:          mergeThreshold(health.MaxSyncPercentage, defaults.MaxSyncPercentage),
+		RoundLag:                   mergeThreshold(health.RoundLag, defaults.RoundLag),
+		SignatureErrorsGrowth:      mergeThreshold(health.SignatureErrorsGrowth, defaults.SignatureErrorsGrowth),
+		SkippedConsensusGrowth:     mergeThreshold(health.SkippedConsensusGrowth, defaults.SkippedConsensusGrowth),
+	}
+}
+
+// Thresholds returns the thresholds of the health checks, the built-in defaults are used for the thresholds not set.
+func (health Health) Thresholds() metrics.Thresholds {
+	thresholds := metrics.DefaultThresholds()
+
+	setThreshold := func(threshold *int, value *int) {
+		if value != nil {
+			*threshold = *value
+		}
+	}
+
+	setThreshold(&thresholds.TransactionsPerSecondLag, health.TransactionsPerSecondLag)
+	setThreshold(&thresholds.CheckpointsPerSecondLag, health.CheckpointsPerSecondLag)
+	setThreshold(&thresholds.LatestCheckpointLag, health.LatestCheckpointLag)
+	setThreshold(&thresholds.HighestSyncedCheckpointLag, health.HighestSyncedCheckpointLag)
+	setThreshold(&thresholds.TxSyncPercentage, health.TxSyncPercentage)
+	setThreshold(&thresholds.CheckpointSyncPercentage, health.CheckpointSyncPercentage)
+	setThreshold(&thresholds.MaxSyncPercentage, health.MaxSyncPercentage)
+	setThreshold(&thresholds.RoundLag, health.RoundLag)
+	setThreshold(&thresholds.SignatureErrorsGrowth, health.SignatureErrorsGrowth)
+	setThreshold(&thresholds.SkippedConsensusGrowth, health.SkippedConsensusGrowth)
+
+	return thresholds
+}
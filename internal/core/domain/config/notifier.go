@@ -0,0 +1,23 @@
+package config
+
+import "fmt"
+
+// Expand returns the notifier with the references to the environment variables in its URL, token and chat ID
+// replaced with their values. It returns an error if a referenced environment variable is not set.
+func (notifier Notifier) Expand() (Notifier, error) {
+	var err error
+
+	if notifier.URL, err = expandEnv(notifier.URL); err != nil {
+		return notifier, fmt.Errorf("url: %w", err)
+	}
+
+	if notifier.Token, err = expandEnv(notifier.Token); err != nil {
+		return notifier, fmt.Errorf("token: %w", err)
+	}
+
+	if notifier.ChatID, err = expandEnv(notifier.ChatID); err != nil {
+		return notifier, fmt.Errorf("chat-id: %w", err)
+	}
+
+	return notifier, nil
+}
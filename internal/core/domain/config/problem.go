@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// Problem describes a single problem found in the configuration file.
+	// The line is zero if the problem can not be attributed to a specific line.
+	Problem struct {
+		File    string
+		Line    int
+		Message string
+	}
+
+	Problems []Problem
+)
+
+// String returns the problem in the "file:line: message" format.
+func (problem Problem) String() string {
+	if problem.Line == 0 {
+		return fmt.Sprintf("%s: %s", problem.File, problem.Message)
+	}
+
+	return fmt.Sprintf("%s:%d: %s", problem.File, problem.Line, problem.Message)
+}
+
+// Error returns all problems, one per line.
+func (problems Problems) Error() string {
+	lines := make([]string, 0, len(problems))
+
+	for _, problem := range problems {
+		lines = append(lines, problem.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/bartosian/suimon/static/templates"
+)
+
+const fileHeader = "# suimon configuration file generated by the suimon config init command.\n# Run 'suimon config lint' after editing it to check it for problems.\n\n"
+
+// NewTemplateConfig returns the configuration from the bundled template of the specified network.
+func NewTemplateConfig(network string) (Config, error) {
+	data, err := templates.Read(network)
+	if err != nil {
+		return Config{}, err
+	}
+
+	config, _ := decodeConfig(fmt.Sprintf("suimon-%s.yaml", network), data)
+	if len(config.Problems) > 0 {
+		return Config{}, config.Problems
+	}
+
+	return config, nil
+}
+
+// FilePath returns the path of the configuration file of the specified network in the specified directory.
+func FilePath(dirPath, network string) string {
+	return filepath.Join(dirPath, fmt.Sprintf("suimon-%s.yaml", network))
+}
+
+// WriteConfigFile writes the configuration to the specified file, creating the parent directory if it does not exist.
+func WriteConfigFile(file string, config Config) error {
+	data := bytes.NewBufferString(fileHeader)
+
+	encoder := yaml.NewEncoder(data)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(config); err != nil {
+		return fmt.Errorf("failed to encode configuration: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return fmt.Errorf("failed to create configuration directory: %w", err)
+	}
+
+	return os.WriteFile(file, data.Bytes(), 0o600)
+}
@@ -0,0 +1,221 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bartosian/suimon/internal/pkg/address"
+)
+
+// suiAddressLength is the maximum number of hex characters of a Sui address.
+const suiAddressLength = 64
+
+// validate checks the hosts entries, the connection settings, the health thresholds and the durations of the configuration
+// and returns the problems found.
+// The addresses are parsed the same way they are parsed when the hosts are polled, duplicates are reported
+// for the addresses pointing to the same endpoint within the same section.
+func (config *Config) validate() Problems {
+	var problems Problems
+
+	addProblem := func(path, format string, args ...any) {
+		problems = append(problems, Problem{
+			File:    config.File,
+			Line:    config.Line(path),
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	validateAddress := func(path, addr string, seen map[string]string, parse func(string) (*address.Endpoint, error)) {
+		endpoint, err := parse(addr)
+		if err != nil {
+			addProblem(path, "invalid %s %q: %v", fieldName(path), addr, err)
+
+			return
+		}
+
+		key := endpoint.Address
+		if endpoint.Port != nil {
+			key = fmt.Sprintf("%s:%s", key, *endpoint.Port)
+		}
+
+		if previous, ok := seen[key]; ok {
+			addProblem(path, "duplicate %s %q, already defined at line %d", fieldName(path), addr, config.Line(previous))
+
+			return
+		}
+
+		seen[key] = path
+	}
+
+	validateConnection := func(path string, connection Connection) {
+		if connection.Timeout < 0 {
+			addProblem(path+".timeout", "invalid timeout: %s", connection.Timeout)
+		}
+
+		if connection.Retries != nil && *connection.Retries < 0 {
+			addProblem(path+".retries", "invalid retries: %d", *connection.Retries)
+		}
+
+		if connection.Backoff < 0 {
+			addProblem(path+".backoff", "invalid backoff: %s", connection.Backoff)
+		}
+
+		if _, err := connection.ClientOptions(); err != nil {
+			addProblem(path, "invalid connection settings: %v", err)
+		}
+	}
+
+	validateHealth := func(path string, health Health) {
+		if path != "" {
+			path += "."
+		}
+
+		path += "health"
+
+		validateLag := func(name string, lag *int) {
+			if lag != nil && *lag < 0 {
+				addProblem(path+"."+name, "invalid %s: %d, must not be negative", name, *lag)
+			}
+		}
+
+		validatePercentage := func(name string, percentage *int) {
+			if percentage != nil && (*percentage < 0 || *percentage > 100) {
+				addProblem(path+"."+name, "invalid %s: %d, must be between 0 and 100", name, *percentage)
+			}
+		}
+
+		validateLag("transactions-per-second-lag", health.TransactionsPerSecondLag)
+		validateLag("checkpoints-per-second-lag", health.CheckpointsPerSecondLag)
+		validateLag("latest-checkpoint-lag", health.LatestCheckpointLag)
+		validateLag("highest-synced-checkpoint-lag", health.HighestSyncedCheckpointLag)
+		validateLag("round-lag", health.RoundLag)
+		validateLag("signature-errors-growth", health.SignatureErrorsGrowth)
+		validateLag("skipped-consensus-growth", health.SkippedConsensusGrowth)
+		validatePercentage("tx-sync-percentage", health.TxSyncPercentage)
+		validatePercentage("checkpoint-sync-percentage", health.CheckpointSyncPercentage)
+
+		if health.MaxSyncPercentage != nil && *health.MaxSyncPercentage < 100 {
+			addProblem(path+".max-sync-percentage", "invalid max-sync-percentage: %d, must be at least 100", *health.MaxSyncPercentage)
+		}
+	}
+
+	validateRPC := func(section string, endpoints []RPCEndpoint) {
+		seen := make(map[string]string)
+
+		for idx, rpc := range endpoints {
+			path := fmt.Sprintf("%s[%d]", section, idx)
+
+			for _, message := range rpc.unknownFields {
+				problems = append(problems, newDecodeProblem(config.File, message))
+			}
+
+			validateConnection(path, rpc.Connection)
+			validateHealth(path, rpc.Health)
+
+			if rpc.Address == "" {
+				addProblem(path, "%s entry must have address defined", section)
+
+				continue
+			}
+
+			validateAddress(path, rpc.Address, seen, address.ParseURL)
+		}
+	}
+
+	validateConnection("connection", config.Connection)
+	validateHealth("", config.Health)
+
+	if len(config.PublicRPC) == 0 {
+		addProblem("public-rpc", "public-rpc must contain at least one address")
+	}
+
+	validateRPC("public-rpc", config.PublicRPC)
+	validateRPC("public-extended-rpc", config.PublicExtendedRPC)
+
+	seenNodesRPC, seenNodesMetrics := make(map[string]string), make(map[string]string)
+	for idx, node := range config.FullNodes {
+		path := fmt.Sprintf("full-nodes[%d]", idx)
+
+		validateConnection(path, node.Connection)
+		validateHealth(path, node.Health)
+
+		if node.JSONRPCAddress == "" && node.MetricsAddress == "" {
+			addProblem(path, "full node must have json-rpc-address or metrics-address defined")
+
+			continue
+		}
+
+		if node.JSONRPCAddress != "" {
+			validateAddress(path+".json-rpc-address", node.JSONRPCAddress, seenNodesRPC, address.ParseURL)
+		}
+
+		if node.MetricsAddress != "" {
+			validateAddress(path+".metrics-address", node.MetricsAddress, seenNodesMetrics, address.ParseURL)
+		}
+	}
+
+	seenValidatorsMetrics := make(map[string]string)
+	for idx, validator := range config.Validators {
+		path := fmt.Sprintf("validators[%d]", idx)
+
+		validateConnection(path, validator.Connection)
+		validateHealth(path, validator.Health)
+
+		if validator.SuiAddress != "" && !isSuiAddress(validator.SuiAddress) {
+			addProblem(path+".sui-address", "invalid sui-address %q: must be a 0x prefixed hex string", validator.SuiAddress)
+		}
+
+		if validator.MetricsAddress == "" {
+			addProblem(path, "validator must have metrics-address defined")
+
+			continue
+		}
+
+		validateAddress(path+".metrics-address", validator.MetricsAddress, seenValidatorsMetrics, address.ParseURL)
+	}
+
+	if config.Alerts.RepeatInterval < 0 {
+		addProblem("alerts.repeat-interval", "invalid alerts repeat-interval: %s", config.Alerts.RepeatInterval)
+	}
+
+	if config.Alerts.SlashingPercentage != nil && *config.Alerts.SlashingPercentage < 0 {
+		addProblem("alerts.slashing-percentage", "invalid alerts slashing-percentage: %v, must not be negative", *config.Alerts.SlashingPercentage)
+	}
+
+	if config.History.Retention < 0 {
+		addProblem("history.retention", "invalid history retention: %s", config.History.Retention)
+	}
+
+	return problems
+}
+
+// isSuiAddress reports whether the value is a 0x prefixed hex string of at most 32 bytes.
+func isSuiAddress(value string) bool {
+	hex, ok := strings.CutPrefix(strings.ToLower(value), "0x")
+	if !ok || hex == "" || len(hex) > suiAddressLength {
+		return false
+	}
+
+	for _, char := range hex {
+		if (char < '0' || char > '9') && (char < 'a' || char > 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldName returns the name of the configuration field from its path, e.g. metrics-address for validators[0].metrics-address.
+func fieldName(path string) string {
+	name := path
+
+	if idx := lastSeparator(path); idx >= 0 && path[idx] == '[' {
+		name = path[:idx]
+	}
+
+	if idx := lastSeparator(name); idx >= 0 {
+		name = name[idx+1:]
+	}
+
+	return name
+}
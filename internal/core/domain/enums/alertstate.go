@@ -0,0 +1,13 @@
+package enums
+
+type AlertState string
+
+const (
+	AlertStateFiring   AlertState = "FIRING"
+	AlertStateResolved AlertState = "RESOLVED"
+	AlertStateInfo     AlertState = "INFO"
+)
+
+func (e AlertState) ToString() string {
+	return string(e)
+}
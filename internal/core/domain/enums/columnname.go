@@ -1,17 +1,22 @@
 package enums
 
+import "strings"
+
 type ColumnName string
 
 // Overview section
 const (
-	ColumnNameIndex   ColumnName = "IDX"
-	ColumnNameHealth  ColumnName = "HEALTH"
-	ColumnNameAddress ColumnName = "ADDRESS"
-	ColumnNamePortRPC ColumnName = "RPC"
-	ColumnNameUptime  ColumnName = "UPTIME DAYS"
-	ColumnNameVersion ColumnName = "VERSION"
-	ColumnNameCommit  ColumnName = "COMMIT"
-	ColumnNameCountry ColumnName = "COUNTRY"
+	ColumnNameIndex          ColumnName = "IDX"
+	ColumnNameHealth         ColumnName = "HEALTH"
+	ColumnNameReference      ColumnName = "REF"
+	ColumnNameAddress        ColumnName = "ADDRESS"
+	ColumnNamePortRPC        ColumnName = "RPC"
+	ColumnNameUptime         ColumnName = "UPTIME DAYS"
+	ColumnNameVersion        ColumnName = "VERSION"
+	ColumnNameCommit         ColumnName = "COMMIT"
+	ColumnNameCountry        ColumnName = "COUNTRY"
+	ColumnNameFailedAttempts ColumnName = "FAILED\nATTEMPTS"
+	ColumnNameReason         ColumnName = "REASON"
 )
 
 // Transactions section
@@ -72,6 +77,7 @@ const (
 	ColumnNameValidatorPendingTotalSuiWithdraw  ColumnName = "PENDING TOTAL\nSUI WITHDRAW"
 	ColumnNameValidatorPendingPoolTokenWithdraw ColumnName = "PENDING POOL\nTOKEN WITHDRAW"
 	ColumnNameValidatorApy                      ColumnName = "APY, %"
+	ColumnNameValidatorTracked                  ColumnName = "TRACKED"
 )
 
 // Epoch section
@@ -93,6 +99,23 @@ const (
 	ColumnNameEpochTotalGasFees                 ColumnName = "TOTAL GAS\nFEES, SUI"
 	ColumnNameEpochTotalStakeRewardsDistributed ColumnName = "TOTAL STAKE REWARDS\nDISTRIBUTED, SUI"
 	ColumnNameEpochLeftoverStorageFundInflow    ColumnName = "LEFTOVER STORAGE FUND\nINFLOW, SUI"
+
+	// The charts of the epochs history dashboard
+	ColumnNameEpochTotalTransactionsChart            ColumnName = "TOTAL TRANSACTIONS PER EPOCH"
+	ColumnNameEpochTotalGasFeesChart                 ColumnName = "TOTAL GAS FEES PER EPOCH, SUI"
+	ColumnNameEpochTotalStakeRewardsDistributedChart ColumnName = "STAKE REWARDS DISTRIBUTED PER EPOCH, SUI"
+	ColumnNameEpochStorageFundBalanceChart           ColumnName = "STORAGE FUND BALANCE, SUI"
+	ColumnNameEpochReferenceGasPriceChart            ColumnName = "REFERENCE GAS PRICE PER EPOCH"
+)
+
+// Active validators dashboard section
+const (
+	ColumnNameActiveValidatorsNakamotoCoefficient ColumnName = "NAKAMOTO\nCOEFFICIENT"
+	ColumnNameActiveValidatorsTopStakeShare       ColumnName = "TOP 10 VALIDATORS\nSTAKE SHARE, %"
+	ColumnNameActiveValidatorsAverageApy          ColumnName = "AVERAGE APY, %"
+	ColumnNameActiveValidatorsVotingPowerChart    ColumnName = "VOTING POWER DISTRIBUTION"
+	ColumnNameActiveValidatorsGasPriceSurvey      ColumnName = "GAS PRICE SURVEY"
+	ColumnNameActiveValidatorsRanking             ColumnName = "VALIDATORS RANKING"
 )
 
 // System State section
@@ -137,6 +160,35 @@ const (
 	ColumnNameSystemStakeSubsidyDecreaseRate              ColumnName = "STAKE SUBSIDY\nDECREASE RATE"
 )
 
+// History section
+const (
+	ColumnNameHistoryTime  ColumnName = "TIME"
+	ColumnNameHistoryTable ColumnName = "TABLE"
+)
+
+// Validators diff section
+const (
+	ColumnNameValidatorsDiffChange    ColumnName = "CHANGE"
+	ColumnNameValidatorsDiffFromEpoch ColumnName = "FROM EPOCH"
+	ColumnNameValidatorsDiffToEpoch   ColumnName = "TO EPOCH"
+	ColumnNameValidatorsDiffDelta     ColumnName = "DELTA"
+)
+
 func (e ColumnName) ToString() string {
 	return string(e)
 }
+
+// ToLabel returns the column name as a single line label, suitable for structured output.
+func (e ColumnName) ToLabel() string {
+	return strings.ReplaceAll(string(e), "\n", " ")
+}
+
+// Alias returns the name of the column used in the dashboards layouts of the configuration, e.g. total-tx-blocks.
+// The words are lowercased and joined with dashes, the percent sign is spelled as pct and the commas are dropped.
+func (e ColumnName) Alias() string {
+	alias := strings.ToLower(string(e))
+	alias = strings.ReplaceAll(alias, "%", "pct")
+	alias = strings.ReplaceAll(alias, ",", "")
+
+	return strings.Join(strings.Fields(alias), "-")
+}
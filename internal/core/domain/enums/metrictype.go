@@ -1,5 +1,7 @@
 package enums
 
+import "strings"
+
 type MetricType string
 
 const (
@@ -29,6 +31,7 @@ const (
 	MetricTypeCurrentRound                 MetricType = "CURRENT_ROUND"
 	MetricTypeHighestProcessedRound        MetricType = "HIGHEST_PROCESSED_ROUND"
 	MetricTypeLastCommittedRound           MetricType = "LAST_COMMITTED_ROUND"
+	MetricTypeRoundsPerSecond              MetricType = "ROUNDS_PER_SECOND"
 	MetricTypePrimaryNetworkPeers          MetricType = "PRIMARY_NETWORK_PEERS"
 	MetricTypeWorkerNetworkPeers           MetricType = "WORKER_NETWORK_PEERS"
 	MetricTypeSkippedConsensusTransactions MetricType = "SKIPPED_CONSENSUS_TRANSACTIONS"
@@ -37,6 +40,32 @@ const (
 	MetricTypeNonConsensusLatencySum       MetricType = "NON_CONSENSUS_LATENCY_SUM"
 )
 
+// metricTypeAliases holds the short names of the metric types which differ from their values.
+var metricTypeAliases = map[string]MetricType{
+	"NETWORK_PEERS":           MetricTypeSuiNetworkPeers,
+	"CHECKPOINT_EXEC_BACKLOG": MetricTypeCheckpointExecBacklog,
+	"TPS":                     MetricTypeTransactionsPerSecond,
+	"CPS":                     MetricTypeCheckpointsPerSecond,
+}
+
+// MetricTypeFromName resolves the metric type by its name, e.g. checkpoint-sync-backlog.
+// The lookup is case-insensitive and accepts both dashes and underscores as the words separator.
+func MetricTypeFromName(name string) MetricType {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	name = strings.ReplaceAll(name, "-", "_")
+
+	if metricType, ok := metricTypeAliases[name]; ok {
+		return metricType
+	}
+
+	return MetricType(name)
+}
+
+// Alias returns the name of the metric type used in the configuration, e.g. checkpoint-sync-backlog.
+func (e MetricType) Alias() string {
+	return strings.ReplaceAll(strings.ToLower(string(e)), "_", "-")
+}
+
 func (e MetricType) ToString() string {
 	return string(e)
 }
@@ -0,0 +1,40 @@
+package enums
+
+import "strings"
+
+type NotifierType string
+
+const (
+	NotifierTypeWebhook  NotifierType = "webhook"
+	NotifierTypeSlack    NotifierType = "slack"
+	NotifierTypeTelegram NotifierType = "telegram"
+	NotifierTypeDiscord  NotifierType = "discord"
+)
+
+// NotifierTypes returns the list of supported notifier types.
+func NotifierTypes() []NotifierType {
+	return []NotifierType{
+		NotifierTypeWebhook,
+		NotifierTypeSlack,
+		NotifierTypeTelegram,
+		NotifierTypeDiscord,
+	}
+}
+
+// NotifierTypeFromString resolves the notifier type by its name. The lookup is case-insensitive
+// and returns false if the notifier type is not supported.
+func NotifierTypeFromString(notifierType string) (NotifierType, bool) {
+	notifierType = strings.ToLower(strings.TrimSpace(notifierType))
+
+	for _, supportedType := range NotifierTypes() {
+		if string(supportedType) == notifierType {
+			return supportedType, true
+		}
+	}
+
+	return "", false
+}
+
+func (e NotifierType) ToString() string {
+	return string(e)
+}
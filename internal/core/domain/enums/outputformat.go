@@ -0,0 +1,42 @@
+package enums
+
+import "strings"
+
+type OutputFormat string
+
+const (
+	OutputFormatTable    OutputFormat = "table"
+	OutputFormatJSON     OutputFormat = "json"
+	OutputFormatCSV      OutputFormat = "csv"
+	OutputFormatMarkdown OutputFormat = "markdown"
+	OutputFormatHTML     OutputFormat = "html"
+)
+
+// OutputFormats returns the list of supported output formats.
+func OutputFormats() []OutputFormat {
+	return []OutputFormat{
+		OutputFormatTable,
+		OutputFormatJSON,
+		OutputFormatCSV,
+		OutputFormatMarkdown,
+		OutputFormatHTML,
+	}
+}
+
+// OutputFormatFromString resolves the output format by its name. The lookup is case-insensitive
+// and returns false if the format is not supported.
+func OutputFormatFromString(format string) (OutputFormat, bool) {
+	format = strings.ToLower(strings.TrimSpace(format))
+
+	for _, outputFormat := range OutputFormats() {
+		if string(outputFormat) == format {
+			return outputFormat, true
+		}
+	}
+
+	return "", false
+}
+
+func (e OutputFormat) ToString() string {
+	return string(e)
+}
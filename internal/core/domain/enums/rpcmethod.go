@@ -8,6 +8,7 @@ const (
 	RPCMethodGetLatestCheckpointSequenceNumber RPCMethod = "sui_getLatestCheckpointSequenceNumber"
 	RPCMethodGetValidatorsApy                  RPCMethod = "suix_getValidatorsApy"
 	RPCMethodGetEpochs                         RPCMethod = "suix_getEpochs"
+	RPCMethodSubscribeEvent                    RPCMethod = "suix_subscribeEvent"
 )
 
 func (e RPCMethod) String() string {
@@ -15,6 +15,36 @@ const (
 	StatusGrey   Status = "\U0001F7E4"
 )
 
+// ToLabel returns the name of the status color, suitable for structured output.
+func (i Status) ToLabel() string {
+	switch i {
+	case StatusGreen:
+		return "green"
+	case StatusYellow:
+		return "yellow"
+	case StatusRed:
+		return "red"
+	case StatusGrey:
+		return "grey"
+	default:
+		return ""
+	}
+}
+
+// StatusFromLabel resolves the status by the name of its color. The lookup is case-insensitive
+// and returns false if the label is unknown.
+func StatusFromLabel(label string) (Status, bool) {
+	label = strings.ToLower(strings.TrimSpace(label))
+
+	for _, status := range []Status{StatusGreen, StatusYellow, StatusRed, StatusGrey} {
+		if status.ToLabel() == label {
+			return status, true
+		}
+	}
+
+	return "", false
+}
+
 func (i Status) StatusToPlaceholder() string {
 	return i.ColorStatus()
 }
@@ -1,5 +1,7 @@
 package enums
 
+import "strings"
+
 type TableType string
 
 const (
@@ -12,8 +14,82 @@ const (
 	TableTypeValidatorsAtRisk   TableType = "🚨 VALIDATORS AT RISK"
 	TableTypeValidatorReports   TableType = "📢 VALIDATORS REPORTS"
 	TableTypeActiveValidators   TableType = "✅ ACTIVE VALIDATORS"
+
+	// TableTypeMetricHistory renders the recorded hosts metrics, it can not be selected on the monitor command.
+	TableTypeMetricHistory TableType = "📈 METRIC HISTORY"
+	// TableTypeValidatorsDiff renders the changes of the validators set between epochs, it can not be selected on the monitor command.
+	TableTypeValidatorsDiff TableType = "🔀 VALIDATORS DIFF"
+	// TableTypeFleet renders the tiles of all configured full nodes and validators, it can be selected as the dynamic dashboard only.
+	TableTypeFleet TableType = "🚢 FLEET"
 )
 
 func (e TableType) ToString() string {
 	return string(e)
 }
+
+// tableTypeAliases holds the short table names accepted on the command line, in the display order.
+var tableTypeAliases = []struct {
+	alias     string
+	tableType TableType
+}{
+	{"rpc", TableTypeRPC},
+	{"node", TableTypeNode},
+	{"validator", TableTypeValidator},
+	{"gas-price", TableTypeGasPriceAndSubsidy},
+	{"epochs-history", TableTypeEpochsHistory},
+	{"validators-params", TableTypeValidatorsParams},
+	{"validators-at-risk", TableTypeValidatorsAtRisk},
+	{"validators-reports", TableTypeValidatorReports},
+	{"active-validators", TableTypeActiveValidators},
+}
+
+// dashboardTypeAliases holds the short names of the dashboards which have no static table counterpart.
+var dashboardTypeAliases = []struct {
+	alias     string
+	tableType TableType
+}{
+	{"fleet", TableTypeFleet},
+}
+
+// TableTypeFromAlias resolves a short table or dashboard name, such as "rpc" or "node", into a TableType.
+// The lookup is case-insensitive and returns false if the alias is unknown.
+func TableTypeFromAlias(alias string) (TableType, bool) {
+	alias = strings.ToLower(strings.TrimSpace(alias))
+
+	for _, entry := range append(tableTypeAliases, dashboardTypeAliases...) {
+		if entry.alias == alias {
+			return entry.tableType, true
+		}
+	}
+
+	return "", false
+}
+
+// TableTypeAliases returns the list of supported short table names.
+func TableTypeAliases() []string {
+	aliases := make([]string, 0, len(tableTypeAliases))
+
+	for _, entry := range tableTypeAliases {
+		aliases = append(aliases, entry.alias)
+	}
+
+	return aliases
+}
+
+// Alias returns the short command-line name of the table type.
+func (e TableType) Alias() string {
+	switch e {
+	case TableTypeMetricHistory:
+		return "metric-history"
+	case TableTypeValidatorsDiff:
+		return "validators-diff"
+	}
+
+	for _, entry := range append(tableTypeAliases, dashboardTypeAliases...) {
+		if entry.tableType == e {
+			return entry.alias
+		}
+	}
+
+	return string(e)
+}
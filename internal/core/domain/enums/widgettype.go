@@ -1,5 +1,7 @@
 package enums
 
+import "strings"
+
 type WidgetType int
 
 const (
@@ -7,4 +9,44 @@ const (
 	WidgetTypeTextNoScroll
 	WidgetTypeDisplay
 	WidgetTypeSparkLine
+	WidgetTypeBarChart
+	WidgetTypeText
 )
+
+// widgetTypeAliases holds the names of the widget types used in the dashboards layouts of the configuration.
+var widgetTypeAliases = []struct {
+	alias      string
+	widgetType WidgetType
+}{
+	{"display", WidgetTypeDisplay},
+	{"text", WidgetTypeText},
+	{"text-no-scroll", WidgetTypeTextNoScroll},
+	{"progress", WidgetTypeProgress},
+	{"sparkline", WidgetTypeSparkLine},
+	{"bar-chart", WidgetTypeBarChart},
+}
+
+// WidgetTypeFromAlias resolves the widget type by its name, e.g. sparkline.
+// The lookup is case-insensitive and returns false if the alias is unknown.
+func WidgetTypeFromAlias(alias string) (WidgetType, bool) {
+	alias = strings.ToLower(strings.TrimSpace(alias))
+
+	for _, entry := range widgetTypeAliases {
+		if entry.alias == alias {
+			return entry.widgetType, true
+		}
+	}
+
+	return 0, false
+}
+
+// Alias returns the name of the widget type used in the configuration.
+func (e WidgetType) Alias() string {
+	for _, entry := range widgetTypeAliases {
+		if entry.widgetType == e {
+			return entry.alias
+		}
+	}
+
+	return "unknown"
+}
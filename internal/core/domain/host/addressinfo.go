@@ -5,6 +5,7 @@ import (
 	"net"
 	"net/url"
 
+	"github.com/bartosian/suimon/internal/core/domain/config"
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/pkg/address"
 )
@@ -22,6 +23,42 @@ const (
 type AddressInfo struct {
 	Endpoint address.Endpoint
 	Ports    map[enums.PortType]string
+
+	// Connection holds the settings of the requests sent to the endpoints of the address.
+	Connection config.Connection
+	// Health holds the thresholds the health of the host is calculated with.
+	Health config.Health
+	// SuiAddress and ValidatorName identify the validator of the address in the system state, set for validators only.
+	SuiAddress    string
+	ValidatorName string
+}
+
+// Key returns the key the addresses are told apart by, the hosts on the same address differ by their ports only.
+func (addr *AddressInfo) Key() string {
+	return fmt.Sprintf("%s|%s|%s", addr.Endpoint.Address, addr.Ports[enums.PortTypeRPC], addr.Ports[enums.PortTypeMetrics])
+}
+
+// HostAddress returns the address of the host along with the RPC port, or the metrics port for the hosts
+// not serving the RPC, so the hosts on the same address are told apart.
+func (addr *AddressInfo) HostAddress() string {
+	address := addr.Endpoint.Address
+
+	switch {
+	case addr.Endpoint.Host != nil:
+		address = *addr.Endpoint.Host
+	case addr.Endpoint.IP != nil:
+		address = *addr.Endpoint.IP
+	}
+
+	if port, ok := addr.Ports[enums.PortTypeRPC]; ok {
+		return address + ":" + port
+	}
+
+	if port, ok := addr.Ports[enums.PortTypeMetrics]; ok {
+		return address + ":" + port
+	}
+
+	return address
 }
 
 // GetUrlRPC generates a URL for the RPC endpoint of the address.
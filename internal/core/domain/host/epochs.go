@@ -0,0 +1,39 @@
+package host
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+// GetEpoch requests the info of the specified epoch, including the validators set of the epoch, from the extended RPC host.
+// The epochs are requested in the ascending order starting after the previous epoch, which is used as the cursor.
+// The current epoch is requested if the epoch is negative.
+func (host *Host) GetEpoch(epoch int) (*metrics.EpochInfo, error) {
+	var (
+		cursor     any
+		descending = epoch < 0
+	)
+
+	if epoch > 0 {
+		cursor = strconv.Itoa(epoch - 1)
+	}
+
+	result, err := host.gateways.rpc.CallFor(enums.RPCMethodGetEpochs, cursor, 1, descending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get epoch %d, host: %s: %w", epoch, host.Endpoint.Address, err)
+	}
+
+	epochsHistory, err := metrics.NewEpochsHistory(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(epochsHistory.Data) == 0 || (!descending && epochsHistory.Data[0].Epoch != strconv.Itoa(epoch)) {
+		return nil, fmt.Errorf("epoch %d not found, host: %s", epoch, host.Endpoint.Address)
+	}
+
+	return &epochsHistory.Data[0], nil
+}
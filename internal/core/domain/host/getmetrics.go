@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -62,6 +63,17 @@ var (
 			enums.RPCMethodGetEpochs,
 		},
 	}
+	// counterRPCMethods holds the RPC methods requesting the counters sampled on the notifications of the host.
+	counterRPCMethods = []enums.RPCMethod{
+		enums.RPCMethodGetTotalTransactionBlocks,
+		enums.RPCMethodGetLatestCheckpointSequenceNumber,
+	}
+	// counterPrometheusMetrics holds the Prometheus metrics requesting the counters sampled on the notifications of the host.
+	counterPrometheusMetrics = ports.Metrics{
+		enums.PrometheusMetricNameHighestSyncedCheckpoint: {
+			MetricType: enums.PrometheusMetricTypeGauge,
+		},
+	}
 	// tablesToCallMetrics maps a table type to a boolean value indicating whether to call metrics for that table type.
 	tablesToCallMetrics = map[enums.TableType]bool{
 		enums.TableTypeNode:      true,
@@ -186,18 +198,15 @@ func (host *Host) GetPrometheusMetrics() error {
 	return nil
 }
 
-// GetMetrics fetches data from the host by calling three different methods asynchronously: GetTotalTransactionNumber, GetLatestCheckpoint, and GetPrometheusMetrics.
-// The function waits for all three methods to complete before returning.
-// Returns an error if any of the three methods fail or return an error.
+// GetMetrics fetches data from the host by requesting the RPC methods of the table in a single batch request and the Prometheus metrics asynchronously.
+// The function waits for both requests to complete before returning.
+// Returns an error if any of the requests fail or return an error.
 func (host *Host) GetMetrics() error {
 	var errGroup errgroup.Group
 
-	rpcMethods := tableToRpcMethods[host.TableType]
-	for _, method := range rpcMethods {
-		method := method
-
+	if rpcMethods := tableToRpcMethods[host.TableType]; len(rpcMethods) > 0 {
 		errGroup.Go(func() error {
-			return host.GetDataByMetric(method)
+			return host.GetDataByMetrics(rpcMethods...)
 		})
 	}
 
@@ -232,3 +241,93 @@ func (host *Host) GetDataByMetric(method enums.RPCMethod) error {
 
 	return host.Metrics.SetValue(metric, result)
 }
+
+// GetDataByMetrics retrieves the data for the RPC methods in a single batch request and stores it as metrics in the Metrics struct.
+// The data of the methods which succeeded is stored even if some of the methods fail, in which case the error of the first failed method is returned.
+func (host *Host) GetDataByMetrics(methods ...enums.RPCMethod) error {
+	requests := make([]ports.RPCRequest, 0, len(methods))
+
+	for _, method := range methods {
+		if _, ok := rpcMethodToMetric[method]; !ok {
+			return fmt.Errorf("unsupported RPC method: %v", method)
+		}
+
+		requests = append(requests, ports.RPCRequest{Method: method, Params: rpcMethodToParams[method]})
+	}
+
+	responses, err := host.gateways.rpc.BatchCallFor(requests...)
+	if err != nil {
+		return err
+	}
+
+	return host.setResponses(methods, responses, time.Now())
+}
+
+// GetCounters requests the counters the transactions and checkpoints per second of the host are calculated from, the RPC ones
+// in a single batch request and the Prometheus ones asynchronously, and records them sampled at the specified time, e.g. the arrival
+// time of the notification they are requested on. The values are recorded once both requests complete, so the metrics are not
+// written concurrently. Returns an error if any of the requests fail.
+func (host *Host) GetCounters(sampledAt time.Time) error {
+	var (
+		errGroup  errgroup.Group
+		responses []ports.RPCResponse
+		result    ports.MetricsResult
+	)
+
+	if len(tableToRpcMethods[host.TableType]) > 0 {
+		requests := make([]ports.RPCRequest, 0, len(counterRPCMethods))
+
+		for _, method := range counterRPCMethods {
+			requests = append(requests, ports.RPCRequest{Method: method})
+		}
+
+		errGroup.Go(func() (err error) {
+			responses, err = host.gateways.rpc.BatchCallFor(requests...)
+
+			return err
+		})
+	}
+
+	if ok := tablesToCallMetrics[host.TableType]; ok {
+		errGroup.Go(func() (err error) {
+			result, err = host.gateways.prometheus.CallFor(counterPrometheusMetrics)
+
+			return err
+		})
+	}
+
+	if err := errGroup.Wait(); err != nil {
+		return fmt.Errorf("failed to get counters for table %s, host: %s: %w", host.TableType, host.Endpoint.Address, err)
+	}
+
+	for metricName, metricValue := range result {
+		if err := host.Metrics.SetSampledValue(prometheusToMetric[metricName], metricValue.Value, sampledAt); err != nil {
+			return err
+		}
+	}
+
+	if responses == nil {
+		return nil
+	}
+
+	return host.setResponses(counterRPCMethods, responses, sampledAt)
+}
+
+// setResponses stores the results of the batch request of the RPC methods as metrics, the counters are sampled at the specified time.
+// The results of the methods which succeeded are stored even if some of the methods fail, in which case the error of the first failed method is returned.
+func (host *Host) setResponses(methods []enums.RPCMethod, responses []ports.RPCResponse, sampledAt time.Time) error {
+	var firstErr error
+
+	for idx, response := range responses {
+		err := response.Err
+		if err == nil {
+			err = host.Metrics.SetSampledValue(rpcMethodToMetric[methods[idx]], response.Result, sampledAt)
+		}
+
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
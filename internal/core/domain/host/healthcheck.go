@@ -0,0 +1,72 @@
+package host
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// metricResponse is the name of the check failing when the host did not respond with its metrics.
+const metricResponse = "response"
+
+type (
+	// HealthCheck holds the failing health check of the host: the metric checked, the value observed on the host,
+	// the value of the reference RPC host it is compared against and the threshold the value must satisfy.
+	HealthCheck struct {
+		Status    enums.Status `json:"-"`
+		Metric    string       `json:"metric"`
+		Value     any          `json:"value"`
+		Reference any          `json:"reference,omitempty"`
+		Threshold string       `json:"threshold,omitempty"`
+	}
+
+	// HealthChecks holds the failing health checks of the host, explaining why the host is not green.
+	HealthChecks []HealthCheck
+)
+
+// String returns the check formatted as "metric: value (reference X, threshold)".
+func (check HealthCheck) String() string {
+	var details []string
+
+	if check.Reference != nil {
+		details = append(details, fmt.Sprintf("reference %v", check.Reference))
+	}
+
+	if check.Threshold != "" {
+		details = append(details, check.Threshold)
+	}
+
+	if len(details) == 0 {
+		return fmt.Sprintf("%s: %v", check.Metric, check.Value)
+	}
+
+	return fmt.Sprintf("%s: %v (%s)", check.Metric, check.Value, strings.Join(details, ", "))
+}
+
+// String returns the checks formatted one per line.
+func (checks HealthChecks) String() string {
+	lines := make([]string, 0, len(checks))
+
+	for _, check := range checks {
+		lines = append(lines, check.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Status returns the status the checks result in: red if any of the checks is critical, yellow if any check failed
+// and green otherwise.
+func (checks HealthChecks) Status() enums.Status {
+	status := enums.StatusGreen
+
+	for _, check := range checks {
+		if check.Status == enums.StatusRed {
+			return enums.StatusRed
+		}
+
+		status = enums.StatusYellow
+	}
+
+	return status
+}
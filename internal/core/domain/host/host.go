@@ -1,6 +1,8 @@
 package host
 
 import (
+	"fmt"
+
 	"github.com/dariubs/percent"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
@@ -26,6 +28,15 @@ type (
 		IPInfo  *ports.IPResult
 		Metrics metrics.Metrics
 
+		// FailedChecks holds the health checks failed by the host, explaining why the status is not green.
+		FailedChecks HealthChecks
+
+		// Reference is set for the RPC host used as the reference for the health of the other hosts.
+		Reference bool
+
+		// OnChain holds the state of the validator in the system state, set for the validators tracked by their Sui address or name.
+		OnChain *OnChain
+
 		gateways Gateways
 	}
 )
@@ -52,15 +63,26 @@ func NewHost(
 	return host
 }
 
+// FailedAttempts returns the number of the failed requests to the RPC and metrics endpoints of the host, including the retried ones.
+func (host *Host) FailedAttempts() int {
+	var failed int
+
+	if host.gateways.rpc != nil {
+		failed += host.gateways.rpc.FailedAttempts()
+	}
+
+	if host.gateways.prometheus != nil {
+		failed += host.gateways.prometheus.FailedAttempts()
+	}
+
+	return failed
+}
+
 // SetPctProgress updates the value of the specified metric type for the Host instance with a percentage that reflects the Host's progress relative to the progress of the RPC Host.
-// The function obtains the current metric value for the Host and RPC Host, calculates the percentage using the percent.PercentOf function, and sets the new percentage value for the Host's Metrics instance for the specified metric type.
+// The percentage is capped at 100, the progress of the hosts ahead of the RPC Host is checked with the uncapped one, see progressPercentage.
 // The second argument is the RPC Host to compare the progress against.
 func (host *Host) SetPctProgress(metricType enums.MetricType, rpc Host) error {
-	hostMetric := host.Metrics.GetValue(metricType)
-	rpcMetric := rpc.Metrics.GetValue(metricType)
-	hostMetricInt, rpcMetricInt := hostMetric.(int), rpcMetric.(int)
-
-	percentage := int(percent.PercentOf(hostMetricInt, rpcMetricInt))
+	percentage := host.progressPercentage(metricType, rpc)
 	if percentage > 100 {
 		percentage = 100
 	}
@@ -68,36 +90,184 @@ func (host *Host) SetPctProgress(metricType enums.MetricType, rpc Host) error {
 	return host.Metrics.SetValue(metricType, percentage)
 }
 
+// progressPercentage returns the uncapped progress of the host relative to the progress of the RPC host in percent,
+// calculated from the metric the specified sync percentage is derived from. It returns 0 if the RPC host reports no progress.
+func (host *Host) progressPercentage(metricType enums.MetricType, rpc Host) int {
+	hostMetric, rpcMetric := host.Metrics.GetValue(metricType).(int), rpc.Metrics.GetValue(metricType).(int)
+
+	if rpcMetric == 0 {
+		return 0
+	}
+
+	return int(percent.PercentOf(hostMetric, rpcMetric))
+}
+
+// SetHealth calculates the metrics derived from the progress of the reference RPC host, such as the sync percentages
+// and the checkpoint backlogs, and the health status of the host. The derived metrics are skipped for the host which failed to respond.
+// The on-chain state of the tracked validator is looked up in the system state of the reference RPC host.
+func (host *Host) SetHealth(rpc Host) error {
+	if err := host.SetOnChain(rpc); err != nil {
+		return err
+	}
+
+	// setting the derived metrics marks the host as updated, so it is skipped for the hosts which failed to respond
+	if !host.Metrics.Updated {
+		host.SetStatus(rpc)
+
+		return nil
+	}
+
+	metrics := host.Metrics
+
+	checkpointExecBacklog := metrics.HighestKnownCheckpoint - metrics.LastExecutedCheckpoint
+	checkpointSyncBacklog := metrics.HighestKnownCheckpoint - metrics.HighestSyncedCheckpoint
+
+	if err := host.SetPctProgress(enums.MetricTypeTxSyncPercentage, rpc); err != nil {
+		return err
+	}
+
+	if err := host.SetPctProgress(enums.MetricTypeCheckSyncPercentage, rpc); err != nil {
+		return err
+	}
+
+	if err := host.Metrics.SetValue(enums.MetricTypeCheckpointExecBacklog, checkpointExecBacklog); err != nil {
+		return err
+	}
+
+	if err := host.Metrics.SetValue(enums.MetricTypeCheckpointSyncBacklog, checkpointSyncBacklog); err != nil {
+		return err
+	}
+
+	host.SetStatus(rpc)
+
+	return nil
+}
+
+// SetStatus calculates the health status of the host by comparing its metrics with the metrics of the reference RPC host.
+// The thresholds of the checks are taken from the health settings of the host, the defaults are used for the ones not set.
+// The failing checks are recorded on the host to explain the status.
 func (host *Host) SetStatus(rpc Host) {
+	host.FailedChecks = host.checkHealth(rpc)
+	host.Status = host.FailedChecks.Status()
+}
+
+// checkHealth returns the failing health checks of the host. The critical checks turning the host red are evaluated
+// first, the checks against the reference RPC host turning it yellow are evaluated only if all the critical checks pass.
+func (host *Host) checkHealth(rpc Host) HealthChecks {
 	metricsHost := host.Metrics
 	metricsRPC := rpc.Metrics
+	thresholds := host.Health.Thresholds()
+
+	if !metricsHost.Updated {
+		return HealthChecks{{Status: enums.StatusRed, Metric: metricResponse, Value: "none"}}
+	}
+
+	var checks HealthChecks
+
+	critical := func(metric enums.MetricType, value any, threshold string) {
+		checks = append(checks, HealthCheck{Status: enums.StatusRed, Metric: metric.Alias(), Value: value, Threshold: threshold})
+	}
+
+	lagging := func(metric enums.MetricType, value, reference any, threshold string) {
+		checks = append(checks, HealthCheck{Status: enums.StatusYellow, Metric: metric.Alias(), Value: value, Reference: reference, Threshold: threshold})
+	}
 
 	switch host.TableType {
 	case enums.TableTypeValidator:
-		if !metricsHost.Updated || metricsHost.Uptime == "" {
-			host.Status = enums.StatusRed
+		if metricsHost.Uptime == "" {
+			critical(enums.MetricTypeUptime, "none", "")
+		}
 
-			return
+		if metricsHost.RoundsPerSecond == 0 && len(metricsHost.RoundsHistory) == metrics.RoundsPerSecondWindow {
+			critical(enums.MetricTypeRoundsPerSecond, 0, "> 0")
+		}
+
+		// the quorum is calculated from the size of the committee reported by the reference RPC, the peers are checked
+		// only for the validators reporting the consensus rounds, since the metrics are missing from the other ones
+		if quorumPeers := metrics.QuorumPeers(len(metricsRPC.SystemState.ActiveValidators)); quorumPeers > 0 && metricsHost.CurrentRound > 0 {
+			quorum := fmt.Sprintf(">= %d", quorumPeers)
+
+			if metricsHost.PrimaryNetworkPeers < quorumPeers {
+				critical(enums.MetricTypePrimaryNetworkPeers, metricsHost.PrimaryNetworkPeers, quorum)
+			}
+
+			if metricsHost.WorkerNetworkPeers < quorumPeers {
+				critical(enums.MetricTypeWorkerNetworkPeers, metricsHost.WorkerNetworkPeers, quorum)
+			}
+		}
+
+		if len(checks) > 0 {
+			return checks
+		}
+
+		if roundGap := metricsHost.CurrentRound - metricsHost.HighestProcessedRound; roundGap > thresholds.RoundLag {
+			lagging(enums.MetricTypeHighestProcessedRound, metricsHost.HighestProcessedRound, metricsHost.CurrentRound,
+				fmt.Sprintf("lag <= %d", thresholds.RoundLag))
+		}
+
+		if previous, growth, ok := metrics.CounterGrowth(metricsHost.TotalSignatureErrorsHistory); ok && growth > thresholds.SignatureErrorsGrowth {
+			lagging(enums.MetricTypeTotalSignatureErrors, metricsHost.TotalSignatureErrors, previous,
+				fmt.Sprintf("growth <= %d", thresholds.SignatureErrorsGrowth))
+		}
+
+		if previous, growth, ok := metrics.CounterGrowth(metricsHost.SkippedConsensusTransactionsHistory); ok && growth > thresholds.SkippedConsensusGrowth {
+			lagging(enums.MetricTypeSkippedConsensusTransactions, metricsHost.SkippedConsensusTransactions, previous,
+				fmt.Sprintf("growth <= %d", thresholds.SkippedConsensusGrowth))
+		}
+
+		if metricsRPC.LatestCheckpoint > 0 && metricsHost.HighestSyncedCheckpoint < metricsRPC.LatestCheckpoint-thresholds.HighestSyncedCheckpointLag {
+			lagging(enums.MetricTypeHighestSyncedCheckpoint, metricsHost.HighestSyncedCheckpoint, metricsRPC.LatestCheckpoint,
+				fmt.Sprintf("lag <= %d", thresholds.HighestSyncedCheckpointLag))
 		}
 	case enums.TableTypeNode, enums.TableTypeRPC:
-		if !metricsHost.Updated || metricsHost.TotalTransactionsBlocks == 0 || metricsHost.LatestCheckpoint == 0 ||
-			metricsHost.TransactionsPerSecond == 0 && len(metricsHost.TransactionsHistory) == metrics.TransactionsPerSecondWindow ||
-			metricsHost.TxSyncPercentage == 0 || metricsHost.TxSyncPercentage > 110 || metricsHost.CheckSyncPercentage > 110 {
+		maxSyncPercentage := fmt.Sprintf("<= %d", thresholds.MaxSyncPercentage)
 
-			host.Status = enums.StatusRed
+		if metricsHost.TotalTransactionsBlocks == 0 {
+			critical(enums.MetricTypeTotalTransactionBlocks, 0, "> 0")
+		}
+
+		if metricsHost.LatestCheckpoint == 0 {
+			critical(enums.MetricTypeLatestCheckpoint, 0, "> 0")
+		}
 
-			return
+		if metricsHost.TransactionsPerSecond == 0 && len(metricsHost.TransactionsHistory) == metrics.TransactionsPerSecondWindow {
+			critical(enums.MetricTypeTransactionsPerSecond, 0, "> 0")
 		}
 
-		if metricsHost.IsUnhealthy(enums.MetricTypeTransactionsPerSecond, metricsRPC.TransactionsPerSecond) ||
-			metricsHost.IsUnhealthy(enums.MetricTypeTotalTransactionBlocks, metricsRPC.TotalTransactionsBlocks) ||
-			metricsHost.IsUnhealthy(enums.MetricTypeLatestCheckpoint, metricsRPC.LatestCheckpoint) {
+		// the sync percentages are capped at 100, so the hosts ahead of the reference are checked with the uncapped progress
+		txProgress := host.progressPercentage(enums.MetricTypeTxSyncPercentage, rpc)
+		checkProgress := host.progressPercentage(enums.MetricTypeCheckSyncPercentage, rpc)
 
-			host.Status = enums.StatusYellow
+		switch {
+		case metricsHost.TxSyncPercentage == 0:
+			critical(enums.MetricTypeTxSyncPercentage, 0, "> 0")
+		case txProgress > thresholds.MaxSyncPercentage:
+			critical(enums.MetricTypeTxSyncPercentage, txProgress, maxSyncPercentage)
+		}
+
+		if checkProgress > thresholds.MaxSyncPercentage {
+			critical(enums.MetricTypeCheckSyncPercentage, checkProgress, maxSyncPercentage)
+		}
+
+		if len(checks) > 0 {
+			return checks
+		}
+
+		if metricsHost.IsUnhealthy(enums.MetricTypeTransactionsPerSecond, metricsRPC.TransactionsPerSecond, thresholds) {
+			lagging(enums.MetricTypeTransactionsPerSecond, metricsHost.TransactionsPerSecond, metricsRPC.TransactionsPerSecond,
+				fmt.Sprintf("lag <= %d", thresholds.TransactionsPerSecondLag))
+		}
+
+		if metricsHost.IsUnhealthy(enums.MetricTypeTotalTransactionBlocks, metricsRPC.TotalTransactionsBlocks, thresholds) {
+			lagging(enums.MetricTypeTxSyncPercentage, metricsHost.TxSyncPercentage, nil,
+				fmt.Sprintf(">= %d", thresholds.TxSyncPercentage))
+		}
 
-			return
+		if metricsHost.IsUnhealthy(enums.MetricTypeLatestCheckpoint, metricsRPC.LatestCheckpoint, thresholds) {
+			lagging(enums.MetricTypeLatestCheckpoint, metricsHost.LatestCheckpoint, metricsRPC.LatestCheckpoint,
+				fmt.Sprintf("lag <= %d or %s >= %d", thresholds.LatestCheckpointLag, enums.MetricTypeCheckSyncPercentage.Alias(), thresholds.CheckpointSyncPercentage))
 		}
 	}
 
-	host.Status = enums.StatusGreen
+	return checks
 }
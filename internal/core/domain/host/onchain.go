@@ -0,0 +1,108 @@
+package host
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+// OnChainColumns holds the columns describing the on-chain state of the tracked validator in the tables and the dashboards.
+var OnChainColumns = []enums.ColumnName{
+	enums.ColumnNameValidatorName,
+	enums.ColumnNameValidatorVotingPower,
+	enums.ColumnNameValidatorNextEpochStake,
+	enums.ColumnNameValidatorCommissionRate,
+	enums.ColumnNameValidatorNextEpochGasPrice,
+	enums.ColumnNameValidatorApy,
+	enums.ColumnNameSystemAtRiskValidatorNumberOfEpochs,
+	enums.ColumnNameSystemValidatorSlashingPercentage,
+}
+
+// OnChain holds the state of the validator of the host in the system state of the reference RPC host.
+// The APY and the slashing percentage are percentages, the next epoch stake is in SUI.
+type OnChain struct {
+	Validator      *metrics.Validator
+	NextEpochStake int64
+	APY            float64
+	EpochsAtRisk   int
+	SlashingPct    float64
+}
+
+// Tracked reports whether the host is a validator identified by its Sui address or name.
+func (host *Host) Tracked() bool {
+	return host.SuiAddress != "" || host.ValidatorName != ""
+}
+
+// SetOnChain looks the validator of the host up in the system state of the reference RPC host by its Sui address or name
+// and records its on-chain state: the APY, the number of epochs it is at risk and the reports on it.
+// The state is reset if the validator is not found among the active validators, and kept if the reference did not respond.
+func (host *Host) SetOnChain(rpc Host) error {
+	if !host.Tracked() || !rpc.Metrics.Updated {
+		return nil
+	}
+
+	systemState := rpc.Metrics.SystemState
+
+	validator := systemState.LookupValidator(host.SuiAddress, host.ValidatorName)
+	if validator == nil {
+		host.OnChain = nil
+
+		return nil
+	}
+
+	nextEpochStake, err := metrics.MistToSui(validator.NextEpochStake)
+	if err != nil {
+		return err
+	}
+
+	onChain := &OnChain{
+		Validator:      validator,
+		NextEpochStake: nextEpochStake,
+		APY:            rpc.Metrics.ValidatorsApyParsed[validator.SuiAddress] * 100,
+	}
+
+	if validatorAtRisk, ok := systemState.LookupValidatorAtRisk(validator.SuiAddress); ok {
+		epochsAtRisk, err := strconv.Atoi(validatorAtRisk.EpochsAtRisk)
+		if err != nil {
+			return fmt.Errorf("unexpected number of epochs at risk for validator %s: %s", validator.SuiAddress, validatorAtRisk.EpochsAtRisk)
+		}
+
+		onChain.EpochsAtRisk = epochsAtRisk
+	}
+
+	if validatorReport, ok := systemState.LookupValidatorReport(validator.SuiAddress); ok {
+		onChain.SlashingPct = validatorReport.SlashingPercentage
+	}
+
+	host.OnChain = onChain
+
+	return nil
+}
+
+// ColumnValues returns the values of the columns describing the on-chain state of the validator, so they are formatted
+// the same way in the tables and the dashboards. The values are nil if the validator is not tracked or was not found in the system state.
+func (onChain *OnChain) ColumnValues() map[enums.ColumnName]any {
+	if onChain == nil {
+		columnValues := make(map[enums.ColumnName]any, len(OnChainColumns))
+		for _, columnName := range OnChainColumns {
+			columnValues[columnName] = nil
+		}
+
+		return columnValues
+	}
+
+	validator := onChain.Validator
+
+	return map[enums.ColumnName]any{
+		enums.ColumnNameValidatorName:                       validator.Name,
+		enums.ColumnNameValidatorVotingPower:                validator.VotingPower,
+		enums.ColumnNameValidatorNextEpochStake:             onChain.NextEpochStake,
+		enums.ColumnNameValidatorCommissionRate:             validator.CommissionRate,
+		enums.ColumnNameValidatorNextEpochGasPrice:          validator.NextEpochGasPrice,
+		enums.ColumnNameValidatorApy:                        strconv.FormatFloat(onChain.APY, 'f', 3, 64),
+		enums.ColumnNameSystemAtRiskValidatorNumberOfEpochs: onChain.EpochsAtRisk,
+		enums.ColumnNameSystemValidatorSlashingPercentage:   fmt.Sprintf("%.2f", onChain.SlashingPct),
+	}
+}
@@ -0,0 +1,28 @@
+package host
+
+import "sort"
+
+// IsMoreAdvanced reports whether the left host is ahead of the right one. The hosts which responded
+// on the last poll are always ahead of the failed ones, otherwise the latest checkpoint and the total
+// transaction blocks are compared.
+func IsMoreAdvanced(left, right Host) bool {
+	leftMetrics, rightMetrics := left.Metrics, right.Metrics
+
+	if leftMetrics.Updated != rightMetrics.Updated {
+		return leftMetrics.Updated
+	}
+
+	if leftMetrics.LatestCheckpoint != rightMetrics.LatestCheckpoint {
+		return leftMetrics.LatestCheckpoint > rightMetrics.LatestCheckpoint
+	}
+
+	return leftMetrics.TotalTransactionsBlocks > rightMetrics.TotalTransactionsBlocks
+}
+
+// SortByProgress orders the hosts by their progress with the most advanced one first.
+// The hosts with the same progress keep their order.
+func SortByProgress(hosts []Host) {
+	sort.SliceStable(hosts, func(left, right int) bool {
+		return IsMoreAdvanced(hosts[left], hosts[right])
+	})
+}
@@ -1,5 +1,12 @@
 package metrics
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
 type (
 	// EpochsHistory represents a list of epoch data returned by an API.
 	EpochsHistory struct {
@@ -9,7 +16,7 @@ type (
 	// EpochInfo represents information about the epoch.
 	EpochInfo struct {
 		Epoch                  string          `json:"epoch"`
-		Validators             []interface{}   `json:"validators"`
+		Validators             Validators      `json:"validators"`
 		EpochTotalTransactions string          `json:"epochTotalTransactions"`
 		FirstCheckpointID      string          `json:"firstCheckpointId"`
 		EpochStartTimestamp    string          `json:"epochStartTimestamp"`
@@ -33,3 +40,18 @@ type (
 		LeftoverStorageFundInflow    string `json:"leftoverStorageFundInflow"`
 	}
 )
+
+// NewEpochsHistory parses the epochs history from the result of the suix_getEpochs RPC method.
+func NewEpochsHistory(value any) (*EpochsHistory, error) {
+	dataBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf(ErrUnexpectedMetricValueType, enums.MetricTypeEpochsHistory, value)
+	}
+
+	var epochsHistory EpochsHistory
+	if err = json.Unmarshal(dataBytes, &epochsHistory); err != nil {
+		return nil, fmt.Errorf(ErrUnexpectedMetricValueType, enums.MetricTypeEpochsHistory, value)
+	}
+
+	return &epochsHistory, nil
+}
@@ -0,0 +1,190 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// EpochSummary holds the summary of the ended epoch. The changes are calculated from the system state observed
+	// before and after the epoch change, the totals are taken from the end of epoch info, if it is available. The amounts are in SUI.
+	EpochSummary struct {
+		Epoch                   int
+		TotalTransactions       *int64
+		TotalGasFees            *int64
+		StakeRewards            *int64
+		StorageFund             int64
+		StorageFundChange       int64
+		ReferenceGasPrice       int64
+		ReferenceGasPriceChange int64
+		ValidatorsJoined        []string
+		ValidatorsLeft          []string
+		CommissionChanges       []CommissionChange
+	}
+
+	// CommissionChange holds the commission rate of the validator, in basis points, before and after the epoch change.
+	CommissionChange struct {
+		Name     string
+		Previous int64
+		Current  int64
+	}
+)
+
+// NewEpochSummary creates the summary of the epoch ended between the previous and the current system state.
+// The end of epoch info of the ended epoch is optional, the totals are left out of the summary without it.
+func NewEpochSummary(previous, current *SuiSystemState, epochInfo *EpochInfo) (*EpochSummary, error) {
+	epoch, err := strconv.Atoi(previous.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected epoch value: %s", previous.Epoch)
+	}
+
+	summary := &EpochSummary{Epoch: epoch}
+
+	previousStorageFund, err := storageFund(previous)
+	if err != nil {
+		return nil, err
+	}
+
+	summary.StorageFund, err = storageFund(current)
+	if err != nil {
+		return nil, err
+	}
+
+	summary.StorageFundChange = summary.StorageFund - previousStorageFund
+
+	previousGasPrice, err := strconv.ParseInt(previous.ReferenceGasPrice, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected reference gas price value: %s", previous.ReferenceGasPrice)
+	}
+
+	summary.ReferenceGasPrice, err = strconv.ParseInt(current.ReferenceGasPrice, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected reference gas price value: %s", current.ReferenceGasPrice)
+	}
+
+	summary.ReferenceGasPriceChange = summary.ReferenceGasPrice - previousGasPrice
+
+	if err := summary.setValidatorsChanges(previous, current); err != nil {
+		return nil, err
+	}
+
+	if epochInfo == nil || epochInfo.EndOfEpochInfo == nil {
+		return summary, nil
+	}
+
+	totalTransactions, err := strconv.ParseInt(epochInfo.EpochTotalTransactions, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected epoch total transactions value: %s", epochInfo.EpochTotalTransactions)
+	}
+
+	totalGasFees, err := MistToSui(epochInfo.EndOfEpochInfo.TotalGasFees)
+	if err != nil {
+		return nil, err
+	}
+
+	stakeRewards, err := MistToSui(epochInfo.EndOfEpochInfo.TotalStakeRewardsDistributed)
+	if err != nil {
+		return nil, err
+	}
+
+	summary.TotalTransactions = &totalTransactions
+	summary.TotalGasFees = &totalGasFees
+	summary.StakeRewards = &stakeRewards
+
+	return summary, nil
+}
+
+// setValidatorsChanges sets the validators which joined and left the active set and the commission rate changes.
+func (summary *EpochSummary) setValidatorsChanges(previous, current *SuiSystemState) error {
+	for address, validator := range current.AddressToValidator {
+		previousValidator, ok := previous.AddressToValidator[address]
+		if !ok {
+			summary.ValidatorsJoined = append(summary.ValidatorsJoined, validator.Name)
+
+			continue
+		}
+
+		if previousValidator.CommissionRate == validator.CommissionRate {
+			continue
+		}
+
+		previousRate, err := strconv.ParseInt(previousValidator.CommissionRate, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unexpected commission rate value: %s", previousValidator.CommissionRate)
+		}
+
+		currentRate, err := strconv.ParseInt(validator.CommissionRate, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unexpected commission rate value: %s", validator.CommissionRate)
+		}
+
+		summary.CommissionChanges = append(summary.CommissionChanges, CommissionChange{
+			Name:     validator.Name,
+			Previous: previousRate,
+			Current:  currentRate,
+		})
+	}
+
+	for address, validator := range previous.AddressToValidator {
+		if _, ok := current.AddressToValidator[address]; !ok {
+			summary.ValidatorsLeft = append(summary.ValidatorsLeft, validator.Name)
+		}
+	}
+
+	sort.Strings(summary.ValidatorsJoined)
+	sort.Strings(summary.ValidatorsLeft)
+	sort.Slice(summary.CommissionChanges, func(left, right int) bool {
+		return summary.CommissionChanges[left].Name < summary.CommissionChanges[right].Name
+	})
+
+	return nil
+}
+
+// String returns the summary formatted one value per line.
+func (summary *EpochSummary) String() string {
+	lines := []string{fmt.Sprintf("epoch %d ended", summary.Epoch)}
+
+	if summary.TotalTransactions != nil {
+		lines = append(lines,
+			fmt.Sprintf("total transactions: %d", *summary.TotalTransactions),
+			fmt.Sprintf("total gas fees: %d SUI", *summary.TotalGasFees),
+			fmt.Sprintf("stake rewards distributed: %d SUI", *summary.StakeRewards),
+		)
+	}
+
+	lines = append(lines,
+		fmt.Sprintf("storage fund: %d SUI (%+d SUI)", summary.StorageFund, summary.StorageFundChange),
+		fmt.Sprintf("reference gas price: %d (%+d)", summary.ReferenceGasPrice, summary.ReferenceGasPriceChange),
+	)
+
+	if len(summary.ValidatorsJoined) > 0 {
+		lines = append(lines, "validators joined: "+strings.Join(summary.ValidatorsJoined, ", "))
+	}
+
+	if len(summary.ValidatorsLeft) > 0 {
+		lines = append(lines, "validators left: "+strings.Join(summary.ValidatorsLeft, ", "))
+	}
+
+	for _, change := range summary.CommissionChanges {
+		lines = append(lines, fmt.Sprintf("commission rate of %s: %d -> %d", change.Name, change.Previous, change.Current))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// storageFund returns the balance of the storage fund in SUI.
+func storageFund(systemState *SuiSystemState) (int64, error) {
+	rebates, err := MistToSui(systemState.StorageFundTotalObjectStorageRebates)
+	if err != nil {
+		return 0, err
+	}
+
+	nonRefundable, err := MistToSui(systemState.StorageFundNonRefundableBalance)
+	if err != nil {
+		return 0, err
+	}
+
+	return rebates + nonRefundable, nil
+}
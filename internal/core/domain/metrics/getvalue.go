@@ -65,6 +65,8 @@ func (metrics *Metrics) GetValue(metric enums.MetricType) MetricValue {
 		return metrics.HighestProcessedRound
 	case enums.MetricTypeLastCommittedRound:
 		return metrics.LastCommittedRound
+	case enums.MetricTypeRoundsPerSecond:
+		return metrics.RoundsPerSecond
 	case enums.MetricTypeCertificatesCreated:
 		return metrics.CertificatesCreated
 	case enums.MetricTypePrimaryNetworkPeers:
@@ -82,6 +84,28 @@ func (metrics *Metrics) GetValue(metric enums.MetricType) MetricValue {
 	}
 }
 
+// GetNumericValue returns the value of the given metric type as a number, so it can be compared against thresholds.
+// For the sync percentages the calculated percentages are returned instead of the values they are based on.
+func (metrics *Metrics) GetNumericValue(metric enums.MetricType) (float64, error) {
+	switch metric {
+	case enums.MetricTypeTxSyncPercentage:
+		return float64(metrics.TxSyncPercentage), nil
+	case enums.MetricTypeCheckSyncPercentage:
+		return float64(metrics.CheckSyncPercentage), nil
+	}
+
+	switch value := metrics.GetValue(metric).(type) {
+	case int:
+		return float64(value), nil
+	case int64:
+		return float64(value), nil
+	case float64:
+		return value, nil
+	default:
+		return 0, fmt.Errorf("metric %s does not have a numeric value", metric)
+	}
+}
+
 // GetMillisecondsTillNextEpoch returns the milliseconds till the next epoch.
 func (metrics *Metrics) GetMillisecondsTillNextEpoch() (int64, error) {
 	epochStartMs, err := strconv.ParseInt(metrics.SystemState.EpochStartTimestampMs, 10, 64)
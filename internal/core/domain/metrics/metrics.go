@@ -1,5 +1,7 @@
 package metrics
 
+import "time"
+
 const (
 	TransactionsPerSecondWindow     = 5
 	CheckpointsPerSecondWindow      = 5
@@ -11,9 +13,38 @@ const (
 	HighestSyncedCheckpointLag      = 30
 	TotalTransactionsSyncPercentage = 99
 	TotalCheckpointsSyncPercentage  = 99
+	MaxSyncPercentage               = 110
+	RoundLag                        = 50
+	SignatureErrorsGrowth           = 10
+	SkippedConsensusGrowth          = 10
+	CounterGrowthWindow             = 2
 )
 
 type (
+	// Thresholds holds the thresholds the metrics of the host are checked against to calculate its health.
+	// The lags are the allowed differences from the reference RPC, the sync percentages are the minimum progress
+	// relative to the reference RPC and the max sync percentage is the progress above which the host is considered broken.
+	// The round lag and the growths apply to the validators: the round lag is the allowed gap between the current and the
+	// highest processed round, the growths are the allowed increases of the error counters between the polls.
+	Thresholds struct {
+		TransactionsPerSecondLag   int
+		CheckpointsPerSecondLag    int
+		LatestCheckpointLag        int
+		HighestSyncedCheckpointLag int
+		TxSyncPercentage           int
+		CheckpointSyncPercentage   int
+		MaxSyncPercentage          int
+		RoundLag                   int
+		SignatureErrorsGrowth      int
+		SkippedConsensusGrowth     int
+	}
+
+	// Sample holds the value of a counter and the time it was taken at.
+	Sample struct {
+		Value int
+		Time  time.Time
+	}
+
 	// Transactions represents information about transactions on the Sui blockchain network.
 	Transactions struct {
 		TotalTransactionsBlocks      int
@@ -24,8 +55,8 @@ type (
 		TotalTransactionEffects      int
 		TransactionsPerSecond        int
 		TxSyncPercentage             int
-		TransactionsHistory          []int
-		CertificatesHistory          []int
+		TransactionsHistory          []Sample
+		CertificatesHistory          []Sample
 	}
 
 	// Checkpoints represents information about checkpoints on the Sui blockchain network.
@@ -38,7 +69,7 @@ type (
 		CheckpointExecBacklog   int
 		CheckpointSyncBacklog   int
 		CheckSyncPercentage     int
-		CheckpointsHistory      []int
+		CheckpointsHistory      []Sample
 	}
 
 	// Rounds represents information about rounds on the Sui blockchain network.
@@ -47,7 +78,7 @@ type (
 		HighestProcessedRound int
 		RoundsPerSecond       int
 		LastCommittedRound    int
-		RoundsHistory         []int
+		RoundsHistory         []Sample
 	}
 
 	// Peers represents information about peers on the Sui blockchain network.
@@ -70,8 +101,10 @@ type (
 
 	// Errors represents information about errors on the Sui blockchain network.
 	Errors struct {
-		SkippedConsensusTransactions int
-		TotalSignatureErrors         int
+		SkippedConsensusTransactions        int
+		TotalSignatureErrors                int
+		SkippedConsensusTransactionsHistory []Sample
+		TotalSignatureErrorsHistory         []Sample
 	}
 
 	// GasPrice represents the different reference gas prices used on the network.
@@ -106,6 +139,22 @@ type (
 	}
 )
 
+// DefaultThresholds returns the thresholds used for the health checks not configured.
+func DefaultThresholds() Thresholds {
+	return Thresholds{
+		TransactionsPerSecondLag:   TransactionsPerSecondLag,
+		CheckpointsPerSecondLag:    CheckpointsPerSecondLag,
+		LatestCheckpointLag:        LatestCheckpointLag,
+		HighestSyncedCheckpointLag: HighestSyncedCheckpointLag,
+		TxSyncPercentage:           TotalTransactionsSyncPercentage,
+		CheckpointSyncPercentage:   TotalCheckpointsSyncPercentage,
+		MaxSyncPercentage:          MaxSyncPercentage,
+		RoundLag:                   RoundLag,
+		SignatureErrorsGrowth:      SignatureErrorsGrowth,
+		SkippedConsensusGrowth:     SkippedConsensusGrowth,
+	}
+}
+
 // NewMetrics initializes a new instance of Metrics with default values.
 func NewMetrics() *Metrics {
 	return &Metrics{
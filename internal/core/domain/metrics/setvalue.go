@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"time"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/pkg/utility"
@@ -24,6 +25,12 @@ const (
 // SetValue updates a metric with the given value, parsing it if necessary.
 // It returns an error if the value type is not supported for the given metric.
 func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
+	return metrics.SetSampledValue(metric, value, time.Now())
+}
+
+// SetSampledValue updates a metric with the given value the same way as SetValue. The counters the per second rates
+// are calculated from are sampled at the specified time, e.g. the arrival time of the notification the value was requested on.
+func (metrics *Metrics) SetSampledValue(metric enums.MetricType, value any, sampledAt time.Time) error {
 	metrics.Updated = true
 
 	var convFToI = func(input float64) int {
@@ -49,7 +56,7 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.TotalTransactionsBlocks = valueInt
-		metrics.CalculateTransactionsRatio()
+		metrics.CalculateTransactionsRatio(sampledAt)
 	case enums.MetricTypeTotalTransactionCertificates:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -90,7 +97,7 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 
 		metrics.HighestSyncedCheckpoint = convFToI(valueFloat)
 
-		metrics.CalculateCheckpointsRatio()
+		metrics.CalculateCheckpointsRatio(sampledAt)
 	case enums.MetricTypeLastExecutedCheckpoint:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -176,7 +183,7 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.HighestProcessedRound = convFToI(valueFloat)
-		metrics.CalculateRoundsRatio()
+		metrics.CalculateRoundsRatio(sampledAt)
 	case enums.MetricTypeLastCommittedRound:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -205,6 +212,8 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.SkippedConsensusTransactions = convFToI(valueFloat)
+		metrics.SkippedConsensusTransactionsHistory = appendSample(
+			metrics.SkippedConsensusTransactionsHistory, metrics.SkippedConsensusTransactions, sampledAt, CounterGrowthWindow)
 	case enums.MetricTypeCertificatesCreated:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -212,7 +221,7 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.CertificatesCreated = convFToI(valueFloat)
-		metrics.CalculateCertificatesRatio()
+		metrics.CalculateCertificatesRatio(sampledAt)
 	case enums.MetricTypeTotalSignatureErrors:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -220,6 +229,8 @@ func (metrics *Metrics) SetValue(metric enums.MetricType, value any) error {
 		}
 
 		metrics.TotalSignatureErrors = convFToI(valueFloat)
+		metrics.TotalSignatureErrorsHistory = appendSample(
+			metrics.TotalSignatureErrorsHistory, metrics.TotalSignatureErrors, sampledAt, CounterGrowthWindow)
 	case enums.MetricTypeNonConsensusLatencySum:
 		valueFloat, ok := value.(float64)
 		if !ok {
@@ -317,16 +328,13 @@ func (metrics *Metrics) SetValidatorsApyValue(value any) error {
 
 // SetEpochsHistoryValue sets the epochs history based on the parsed data.
 func (metrics *Metrics) SetEpochsHistoryValue(value any) error {
-	// Parse the JSON data of the EpochsHistory object.
-	dataBytes, err := json.Marshal(value.(map[string]interface{}))
-	if err != nil {
+	if _, ok := value.(map[string]interface{}); !ok {
 		return fmt.Errorf(ErrUnexpectedMetricValueType, enums.MetricTypeEpochsHistory, value)
 	}
 
-	// Unmarshal the JSON data into a EpochsHistory struct.
-	var epochsHistory EpochsHistory
-	if err = json.Unmarshal(dataBytes, &epochsHistory); err != nil {
-		return fmt.Errorf(ErrUnexpectedMetricValueType, enums.MetricTypeEpochsHistory, value)
+	epochsHistory, err := NewEpochsHistory(value)
+	if err != nil {
+		return err
 	}
 
 	metrics.EpochsHistory = epochsHistory.Data[1:]
@@ -405,137 +413,128 @@ func (metrics *Metrics) setRefGasPriceMetrics() error {
 }
 
 // CalculateTransactionsRatio calculates the current transaction per second (TPS) based on the number of transactions processed
-// within the current period. The TPS value is then stored in the Metrics struct.
-func (metrics *Metrics) CalculateTransactionsRatio() {
-	var (
-		transactionsHistory = metrics.TransactionsHistory
-		transactionsStart   int
-		transactionsEnd     int
-	)
-
-	transactionsHistory = append(transactionsHistory, metrics.TotalTransactionsBlocks)
-	if len(transactionsHistory) < TransactionsPerSecondWindow {
-		metrics.TransactionsHistory = transactionsHistory
+// within the current period and the time elapsed between the samples. The TPS value is then stored in the Metrics struct.
+func (metrics *Metrics) CalculateTransactionsRatio(sampledAt time.Time) {
+	metrics.TransactionsHistory, metrics.TransactionsPerSecond = calculateRatio(
+		metrics.TransactionsHistory, metrics.TotalTransactionsBlocks, sampledAt, TransactionsPerSecondWindow, metrics.TransactionsPerSecond)
+}
 
-		return
-	}
+// CalculateCheckpointsRatio calculates the current checkpoints per second (CPS) based on the number of checkpoints generated
+// within the current period and the time elapsed between the samples. The CPS value is then stored in the Metrics struct.
+func (metrics *Metrics) CalculateCheckpointsRatio(sampledAt time.Time) {
+	metrics.CheckpointsHistory, metrics.CheckpointsPerSecond = calculateRatio(
+		metrics.CheckpointsHistory, metrics.HighestSyncedCheckpoint, sampledAt, CheckpointsPerSecondWindow, metrics.CheckpointsPerSecond)
+}
 
-	if len(transactionsHistory) > TransactionsPerSecondWindow {
-		transactionsHistory = transactionsHistory[1:]
-	}
+// CopyCounters copies the counters the transactions and checkpoints per second are calculated from, along with
+// their histories and rates, from the source metrics, e.g. the ones updated on the notifications of the host.
+func (metrics *Metrics) CopyCounters(source Metrics) {
+	metrics.TotalTransactionsBlocks = source.TotalTransactionsBlocks
+	metrics.TransactionsHistory = source.TransactionsHistory
+	metrics.TransactionsPerSecond = source.TransactionsPerSecond
+	metrics.LatestCheckpoint = source.LatestCheckpoint
+	metrics.HighestSyncedCheckpoint = source.HighestSyncedCheckpoint
+	metrics.CheckpointsHistory = source.CheckpointsHistory
+	metrics.CheckpointsPerSecond = source.CheckpointsPerSecond
+}
 
-	transactionsStart = transactionsHistory[0]
-	transactionsEnd = transactionsHistory[TransactionsPerSecondWindow-1]
+// CalculateRoundsRatio calculates the current rounds per second (RPS) based on the number of rounds processed
+// within the current period and the time elapsed between the samples. The RPS value is then stored in the Metrics struct.
+func (metrics *Metrics) CalculateRoundsRatio(sampledAt time.Time) {
+	metrics.RoundsHistory, metrics.RoundsPerSecond = calculateRatio(
+		metrics.RoundsHistory, metrics.HighestProcessedRound, sampledAt, RoundsPerSecondWindow, metrics.RoundsPerSecond)
+}
 
-	metrics.TransactionsHistory = transactionsHistory
-	metrics.TransactionsPerSecond = transactionsEnd - transactionsStart
+// CalculateCertificatesRatio calculates the current certificates per second (CPS) based on the number of certificates created
+// within the current period and the time elapsed between the samples. The CPS value is then stored in the Metrics struct.
+func (metrics *Metrics) CalculateCertificatesRatio(sampledAt time.Time) {
+	metrics.CertificatesHistory, metrics.CertificatesPerSecond = calculateRatio(
+		metrics.CertificatesHistory, metrics.CertificatesCreated, sampledAt, CertificatesPerSecondWindow, metrics.CertificatesPerSecond)
 }
 
-// CalculateCheckpointsRatio calculates the current checkpoints per second (CPS) based on the number of checkpoints generated
-// within the current period. The CPS value is then stored in the Metrics struct.
-func (metrics *Metrics) CalculateCheckpointsRatio() {
-	var (
-		checkpointsHistory = metrics.CheckpointsHistory
-		checkpointsStart   int
-		checkpointsEnd     int
-	)
+// calculateRatio appends the value sampled at the specified time to the history, keeping the last window samples, and returns the history
+// with the per second rate of the value between the first and the last samples. The samples are taken whenever the data
+// arrives, so the rate is based on the time elapsed between them rather than on their number. Any progress within
+// the window results in the rate of at least one. The current rate is returned until the window is filled.
+func calculateRatio(history []Sample, value int, sampledAt time.Time, window int, current int) ([]Sample, int) {
+	history = appendSample(history, value, sampledAt, window)
+	if len(history) < window {
+		return history, current
+	}
 
-	checkpointsHistory = append(checkpointsHistory, metrics.HighestSyncedCheckpoint)
-	if len(checkpointsHistory) < CheckpointsPerSecondWindow {
-		metrics.CheckpointsHistory = checkpointsHistory
+	first, last := history[0], history[window-1]
 
-		return
+	elapsed := last.Time.Sub(first.Time).Seconds()
+	if elapsed <= 0 {
+		return history, current
 	}
 
-	if len(checkpointsHistory) > CheckpointsPerSecondWindow {
-		checkpointsHistory = checkpointsHistory[1:]
+	progress := last.Value - first.Value
+	if progress <= 0 {
+		return history, 0
 	}
 
-	checkpointsStart = checkpointsHistory[0]
-	checkpointsEnd = checkpointsHistory[CheckpointsPerSecondWindow-1]
-
-	metrics.CheckpointsHistory = checkpointsHistory
-	metrics.CheckpointsPerSecond = checkpointsEnd - checkpointsStart
+	return history, int(math.Ceil(float64(progress) / elapsed))
 }
 
-// CalculateRoundsRatio calculates the current rounds per second (RPS) based on the number of rounds processed
-// within the current period. The RPS value is then stored in the Metrics struct.
-func (metrics *Metrics) CalculateRoundsRatio() {
-	var (
-		roundsHistory = metrics.RoundsHistory
-		roundsStart   int
-		roundsEnd     int
-	)
-
-	roundsHistory = append(roundsHistory, metrics.HighestProcessedRound)
-	if len(roundsHistory) < RoundsPerSecondWindow {
-		metrics.RoundsHistory = roundsHistory
-
-		return
+// appendSample appends the value of the counter sampled at the specified time to the history, keeping the last window samples only.
+// The sample is appended to a new copy of the history, since the copies of the host share the history with it
+// and must not write into the same backing array when they are polled concurrently.
+func appendSample(history []Sample, value int, sampledAt time.Time, window int) []Sample {
+	history = append(history[:len(history):len(history)], Sample{Value: value, Time: sampledAt})
+	if len(history) > window {
+		history = history[len(history)-window:]
 	}
 
-	if len(roundsHistory) > RoundsPerSecondWindow {
-		roundsHistory = roundsHistory[1:]
-	}
-
-	roundsStart = roundsHistory[0]
-	roundsEnd = roundsHistory[RoundsPerSecondWindow-1]
-
-	metrics.RoundsHistory = roundsHistory
-	metrics.RoundsPerSecond = roundsEnd - roundsStart
+	return history
 }
 
-// CalculateCertificatesRatio calculates the current certificates per second (CPS) based on the number of certificates created
-// within the current period. The CPS value is then stored in the Metrics struct.
-func (metrics *Metrics) CalculateCertificatesRatio() {
-	var (
-		certificatesHistory = metrics.CertificatesHistory
-		certificatesStart   int
-		certificatesEnd     int
-	)
+// CounterGrowth returns the previous value of the counter and its growth since the previous poll.
+// The flag is false until the counter is polled twice.
+func CounterGrowth(history []Sample) (previous int, growth int, ok bool) {
+	if len(history) < 2 {
+		return 0, 0, false
+	}
 
-	certificatesHistory = append(certificatesHistory, metrics.CertificatesCreated)
-	if len(certificatesHistory) < CertificatesPerSecondWindow {
-		metrics.CertificatesHistory = certificatesHistory
+	previous = history[len(history)-2].Value
 
-		return
-	}
+	return previous, history[len(history)-1].Value - previous, true
+}
 
-	if len(certificatesHistory) > CertificatesPerSecondWindow {
-		certificatesHistory = certificatesHistory[1:]
+// QuorumPeers returns the minimum number of the peers a validator must be connected to in a committee of the given size,
+// so the validator and its peers form the quorum of two thirds of the committee. Zero is returned if the size is unknown.
+func QuorumPeers(committeeSize int) int {
+	if committeeSize <= 0 {
+		return 0
 	}
 
-	certificatesStart = certificatesHistory[0]
-	certificatesEnd = certificatesHistory[CertificatesPerSecondWindow-1]
-
-	metrics.CertificatesHistory = certificatesHistory
-	metrics.CertificatesPerSecond = certificatesEnd - certificatesStart
+	return (2*committeeSize+2)/3 - 1
 }
 
 // IsHealthy checks if the given metric's value satisfies the threshold defined for it.
 // If the metric type is not recognized, returns true.
 // The valueRPC argument is the value retrieved from the Sui RPC endpoint for the corresponding metric.
 // Returns true if the metric value is healthy, false otherwise.
-func (metrics *Metrics) IsHealthy(metric enums.MetricType, valueRPC any) bool {
+func (metrics *Metrics) IsHealthy(metric enums.MetricType, valueRPC any, thresholds Thresholds) bool {
 	switch metric {
 	case enums.MetricTypeTotalTransactionBlocks:
-		return metrics.TxSyncPercentage >= TotalTransactionsSyncPercentage
+		return metrics.TxSyncPercentage >= thresholds.TxSyncPercentage
 	case enums.MetricTypeTransactionsPerSecond:
 		valueRPCInt := valueRPC.(int)
 
-		return metrics.TransactionsPerSecond >= valueRPCInt-TransactionsPerSecondLag
+		return metrics.TransactionsPerSecond >= valueRPCInt-thresholds.TransactionsPerSecondLag
 	case enums.MetricTypeLatestCheckpoint:
 		valueRPCInt := valueRPC.(int)
 
-		return metrics.CheckSyncPercentage >= TotalCheckpointsSyncPercentage || metrics.LatestCheckpoint >= valueRPCInt-LatestCheckpointLag
+		return metrics.CheckSyncPercentage >= thresholds.CheckpointSyncPercentage || metrics.LatestCheckpoint >= valueRPCInt-thresholds.LatestCheckpointLag
 	case enums.MetricTypeHighestSyncedCheckpoint:
 		valueRPCInt := valueRPC.(int)
 
-		return metrics.CheckSyncPercentage >= TotalCheckpointsSyncPercentage || metrics.HighestSyncedCheckpoint >= valueRPCInt-HighestSyncedCheckpointLag
+		return metrics.CheckSyncPercentage >= thresholds.CheckpointSyncPercentage || metrics.HighestSyncedCheckpoint >= valueRPCInt-thresholds.HighestSyncedCheckpointLag
 	case enums.MetricTypeCheckpointsPerSecond:
 		valueRPCInt := valueRPC.(int)
 
-		return metrics.CheckpointsPerSecond >= valueRPCInt-CheckpointsPerSecondLag
+		return metrics.CheckpointsPerSecond >= valueRPCInt-thresholds.CheckpointsPerSecondLag
 	case enums.MetricTypeVersion:
 		return metrics.Version == valueRPC
 	}
@@ -543,8 +542,8 @@ func (metrics *Metrics) IsHealthy(metric enums.MetricType, valueRPC any) bool {
 	return true
 }
 
-func (metrics *Metrics) IsUnhealthy(metric enums.MetricType, valueRPC any) bool {
-	return !metrics.IsHealthy(metric, valueRPC)
+func (metrics *Metrics) IsUnhealthy(metric enums.MetricType, valueRPC any, thresholds Thresholds) bool {
+	return !metrics.IsHealthy(metric, valueRPC, thresholds)
 }
 
 // GetMinRefGasPrice returns the minimum reference gas price among all validators.
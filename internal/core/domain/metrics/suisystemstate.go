@@ -3,6 +3,7 @@ package metrics
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/dariubs/percent"
 )
@@ -71,6 +72,7 @@ type (
 	// ValidatorReport represents validator reporters
 	ValidatorReport struct {
 		Name               string
+		Address            string
 		SlashingPercentage float64
 		Reporters          []ValidatorReporter
 	}
@@ -183,7 +185,7 @@ func (systemState *SuiSystemState) parseValidatorReports() error {
 		}
 
 		slashingPercentage := percent.PercentOf(cumulativePower, validatorsQuorum)
-		validatorReport := NewValidatorReport(reportedValidator.Name, slashingPercentage, validatorReporters)
+		validatorReport := NewValidatorReport(reportedValidator.Name, reportedAddress, slashingPercentage, validatorReporters)
 
 		validatorsReports = append(validatorsReports, validatorReport)
 	}
@@ -193,6 +195,56 @@ func (systemState *SuiSystemState) parseValidatorReports() error {
 	return nil
 }
 
+// LookupValidator returns the active validator with the specified Sui address or, if the address is not provided, with the specified name.
+// The name is compared case-insensitively. It returns nil if the validator is not found among the active validators.
+func (systemState *SuiSystemState) LookupValidator(address, name string) *Validator {
+	if address != "" {
+		for validatorAddress, validator := range systemState.AddressToValidator {
+			if strings.EqualFold(validatorAddress, address) {
+				return validator
+			}
+		}
+
+		return nil
+	}
+
+	if name == "" {
+		return nil
+	}
+
+	for _, validator := range systemState.ActiveValidators {
+		if strings.EqualFold(validator.Name, name) {
+			return validator
+		}
+	}
+
+	return nil
+}
+
+// LookupValidatorAtRisk returns the at risk record of the validator with the specified Sui address.
+// The second return value is false if the validator is not at risk.
+func (systemState *SuiSystemState) LookupValidatorAtRisk(address string) (ValidatorAtRisk, bool) {
+	for _, validatorAtRisk := range systemState.ValidatorsAtRiskParsed {
+		if validatorAtRisk.Address == address {
+			return validatorAtRisk, true
+		}
+	}
+
+	return ValidatorAtRisk{}, false
+}
+
+// LookupValidatorReport returns the report on the validator with the specified Sui address.
+// The second return value is false if the validator was not reported.
+func (systemState *SuiSystemState) LookupValidatorReport(address string) (ValidatorReport, bool) {
+	for _, validatorReport := range systemState.ValidatorReportsParsed {
+		if validatorReport.Address == address {
+			return validatorReport, true
+		}
+	}
+
+	return ValidatorReport{}, false
+}
+
 // NewValidatorReporter creates a new ValidatorReporter instance with the specified
 // name, address, and voting power.
 func NewValidatorReporter(name, address string, votingPower int) ValidatorReporter {
@@ -204,10 +256,11 @@ func NewValidatorReporter(name, address string, votingPower int) ValidatorReport
 }
 
 // NewValidatorReport creates a new ValidatorReport instance with the specified
-// name, slashing percentage, and reporters.
-func NewValidatorReport(name string, slashingPct float64, reporters []ValidatorReporter) ValidatorReport {
+// name, address, slashing percentage, and reporters.
+func NewValidatorReport(name, address string, slashingPct float64, reporters []ValidatorReporter) ValidatorReport {
 	return ValidatorReport{
 		Name:               name,
+		Address:            address,
 		SlashingPercentage: slashingPct,
 		Reporters:          reporters,
 	}
@@ -54,6 +54,7 @@ type (
 		ExchangeRatesID              string      `json:"exchangeRatesId"`
 		ExchangeRatesSize            string      `json:"exchangeRatesSize"`
 		APY                          string
+		Tracked                      bool
 	}
 )
 
@@ -228,3 +229,119 @@ func (validators Validators) GetNextRefGasPrice() (int, error) {
 
 	return referenceGasPrice, nil
 }
+
+// GetVotingPowerDistribution returns the voting powers of the validators in the descending order along with the
+// Nakamoto coefficient, the minimum number of validators whose combined voting power exceeds one third of the total
+// voting power and which are able to halt the network. If any validator has an invalid voting power, it returns an error.
+func (validators Validators) GetVotingPowerDistribution() ([]int, int, error) {
+	votingPowers := make([]int, 0, len(validators))
+
+	var totalVotingPower int
+
+	for _, validator := range validators {
+		validatorVotingPower, err := strconv.Atoi(validator.VotingPower)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unexpected metric value type for VotingPower: %s", validator.VotingPower)
+		}
+
+		votingPowers = append(votingPowers, validatorVotingPower)
+		totalVotingPower += validatorVotingPower
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(votingPowers)))
+
+	var cumulativePower, nakamotoCoefficient int
+
+	for _, votingPower := range votingPowers {
+		if cumulativePower*3 > totalVotingPower {
+			break
+		}
+
+		cumulativePower += votingPower
+		nakamotoCoefficient++
+	}
+
+	return votingPowers, nakamotoCoefficient, nil
+}
+
+// GetGasPriceSurvey returns the next epoch gas prices of the validators in the ascending order along with the index
+// of the validator whose gas price is selected as the next reference gas price, the one which brings the cumulative
+// voting power to the two-thirds quorum as calculated by GetNextRefGasPrice. The validators are not reordered.
+// If any validator has an invalid gas price or voting power, it returns an error.
+func (validators Validators) GetGasPriceSurvey() ([]int, int, error) {
+	type survey struct {
+		gasPrice    int
+		votingPower int
+	}
+
+	surveys := make([]survey, 0, len(validators))
+
+	for _, validator := range validators {
+		validatorGasPrice, err := strconv.Atoi(validator.NextEpochGasPrice)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unexpected metric value type for NextEpochGasPrice: %s", validator.NextEpochGasPrice)
+		}
+
+		validatorVotingPower, err := strconv.Atoi(validator.VotingPower)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unexpected metric value type for VotingPower: %s", validator.VotingPower)
+		}
+
+		surveys = append(surveys, survey{gasPrice: validatorGasPrice, votingPower: validatorVotingPower})
+	}
+
+	sort.SliceStable(surveys, func(left, right int) bool {
+		return surveys[left].gasPrice < surveys[right].gasPrice
+	})
+
+	gasPrices := make([]int, 0, len(surveys))
+	cumulativePower := 0
+	quorumIndex := 0
+
+	for idx, survey := range surveys {
+		gasPrices = append(gasPrices, survey.gasPrice)
+
+		if cumulativePower < validatorsQuorum {
+			quorumIndex = idx
+			cumulativePower += survey.votingPower
+		}
+	}
+
+	return gasPrices, quorumIndex, nil
+}
+
+// GetStakeShare returns the share of the total next epoch stake, in percents, held by the specified number of
+// validators with the highest stake. If any validator has an invalid stake, it returns an error.
+func (validators Validators) GetStakeShare(top int) (float64, error) {
+	stakes := make([]float64, 0, len(validators))
+
+	var totalStake float64
+
+	for _, validator := range validators {
+		validatorStake, err := strconv.ParseFloat(validator.NextEpochStake, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unexpected metric value type for NextEpochStake: %s", validator.NextEpochStake)
+		}
+
+		stakes = append(stakes, validatorStake)
+		totalStake += validatorStake
+	}
+
+	if totalStake == 0 {
+		return 0, nil
+	}
+
+	sort.Sort(sort.Reverse(sort.Float64Slice(stakes)))
+
+	if top < len(stakes) {
+		stakes = stakes[:top]
+	}
+
+	var topStake float64
+
+	for _, stake := range stakes {
+		topStake += stake
+	}
+
+	return topStake / totalStake * 100, nil
+}
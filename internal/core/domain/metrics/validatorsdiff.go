@@ -0,0 +1,186 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ValidatorChangeType is the type of the change of the validator between two epochs.
+type ValidatorChangeType string
+
+const (
+	ValidatorChangeJoined         ValidatorChangeType = "joined"
+	ValidatorChangeRemoved        ValidatorChangeType = "removed"
+	ValidatorChangeVotingPower    ValidatorChangeType = "voting power"
+	ValidatorChangeStake          ValidatorChangeType = "stake, SUI"
+	ValidatorChangeGasPrice       ValidatorChangeType = "gas price"
+	ValidatorChangeCommissionRate ValidatorChangeType = "commission rate"
+)
+
+// validatorChangeOrder holds the order the change types are listed in the diff.
+var validatorChangeOrder = map[ValidatorChangeType]int{
+	ValidatorChangeJoined:         0,
+	ValidatorChangeRemoved:        1,
+	ValidatorChangeVotingPower:    2,
+	ValidatorChangeStake:          3,
+	ValidatorChangeGasPrice:       4,
+	ValidatorChangeCommissionRate: 5,
+}
+
+type (
+	// ValidatorsDiff holds the changes of the active validators set between two epochs.
+	ValidatorsDiff struct {
+		FromEpoch string
+		ToEpoch   string
+		Changes   []ValidatorChange
+	}
+
+	// ValidatorChange holds a single change of the validator between two epochs. The joined and removed validators
+	// are reported with their stake, which is zero in the epoch the validator was not active in.
+	ValidatorChange struct {
+		Type       ValidatorChangeType
+		Name       string
+		SuiAddress string
+		Previous   int64
+		Current    int64
+	}
+)
+
+// Delta returns the difference between the current and the previous value.
+func (change ValidatorChange) Delta() int64 {
+	return change.Current - change.Previous
+}
+
+// NewValidatorsDiff compares the validators sets of the epochs and returns the validators which joined or were removed from the set
+// and the changes of the voting power, stake, gas price and commission rate of the validators active in both epochs.
+// The changes are grouped by their type and sorted by the magnitude of the change within the group.
+func NewValidatorsDiff(from, to *EpochInfo) (*ValidatorsDiff, error) {
+	diff := &ValidatorsDiff{
+		FromEpoch: from.Epoch,
+		ToEpoch:   to.Epoch,
+	}
+
+	fromValidators := make(map[string]*Validator, len(from.Validators))
+	for _, validator := range from.Validators {
+		fromValidators[validator.SuiAddress] = validator
+	}
+
+	toValidators := make(map[string]*Validator, len(to.Validators))
+
+	for _, validator := range to.Validators {
+		toValidators[validator.SuiAddress] = validator
+
+		previous, ok := fromValidators[validator.SuiAddress]
+		if !ok {
+			stake, err := MistToSui(validator.StakingPoolSuiBalance)
+			if err != nil {
+				return nil, err
+			}
+
+			diff.add(ValidatorChangeJoined, validator, 0, stake)
+
+			continue
+		}
+
+		if err := diff.compare(previous, validator); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, validator := range from.Validators {
+		if _, ok := toValidators[validator.SuiAddress]; ok {
+			continue
+		}
+
+		stake, err := MistToSui(validator.StakingPoolSuiBalance)
+		if err != nil {
+			return nil, err
+		}
+
+		diff.add(ValidatorChangeRemoved, validator, stake, 0)
+	}
+
+	sort.SliceStable(diff.Changes, func(left, right int) bool {
+		leftChange, rightChange := diff.Changes[left], diff.Changes[right]
+
+		if leftChange.Type != rightChange.Type {
+			return validatorChangeOrder[leftChange.Type] < validatorChangeOrder[rightChange.Type]
+		}
+
+		leftDelta, rightDelta := abs(leftChange.Delta()), abs(rightChange.Delta())
+		if leftDelta != rightDelta {
+			return leftDelta > rightDelta
+		}
+
+		return leftChange.Name < rightChange.Name
+	})
+
+	return diff, nil
+}
+
+// compare adds the changes of the parameters of the validator active in both epochs.
+func (diff *ValidatorsDiff) compare(previous, current *Validator) error {
+	previousStake, err := MistToSui(previous.StakingPoolSuiBalance)
+	if err != nil {
+		return err
+	}
+
+	currentStake, err := MistToSui(current.StakingPoolSuiBalance)
+	if err != nil {
+		return err
+	}
+
+	if previousStake != currentStake {
+		diff.add(ValidatorChangeStake, current, previousStake, currentStake)
+	}
+
+	parameters := []struct {
+		changeType        ValidatorChangeType
+		previous, current string
+	}{
+		{ValidatorChangeVotingPower, previous.VotingPower, current.VotingPower},
+		{ValidatorChangeGasPrice, previous.GasPrice, current.GasPrice},
+		{ValidatorChangeCommissionRate, previous.CommissionRate, current.CommissionRate},
+	}
+
+	for _, parameter := range parameters {
+		if parameter.previous == parameter.current {
+			continue
+		}
+
+		previousValue, err := strconv.ParseInt(parameter.previous, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unexpected %s value for validator %s: %s", parameter.changeType, previous.SuiAddress, parameter.previous)
+		}
+
+		currentValue, err := strconv.ParseInt(parameter.current, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unexpected %s value for validator %s: %s", parameter.changeType, current.SuiAddress, parameter.current)
+		}
+
+		diff.add(parameter.changeType, current, previousValue, currentValue)
+	}
+
+	return nil
+}
+
+// add appends the change of the validator to the diff.
+func (diff *ValidatorsDiff) add(changeType ValidatorChangeType, validator *Validator, previous, current int64) {
+	diff.Changes = append(diff.Changes, ValidatorChange{
+		Type:       changeType,
+		Name:       validator.Name,
+		SuiAddress: validator.SuiAddress,
+		Previous:   previous,
+		Current:    current,
+	})
+}
+
+// abs returns the absolute value of the integer.
+func abs(value int64) int64 {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
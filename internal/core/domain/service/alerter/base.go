@@ -0,0 +1,90 @@
+package alerter
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+)
+
+type (
+	// Engine evaluates the alert rules against the polled hosts and keeps the state of the alerts,
+	// so every alert is reported once when it fires, repeated on the repeat interval and reported once when it resolves.
+	Engine struct {
+		lock sync.Mutex
+
+		network string
+		rules   []*Rule
+		alerts  map[alertKey]*alert
+	}
+
+	// alertKey identifies the alert of a single rule for a single host, the host is identified by its key,
+	// so the hosts sharing an address keep their alerts apart.
+	alertKey struct {
+		rule  string
+		table string
+		host  string
+	}
+
+	// alert holds the state of the alert of a single rule for a single host.
+	alert struct {
+		pendingSince time.Time
+		firing       bool
+		notifiedAt   time.Time
+	}
+)
+
+// NewEngine parses the alert rules from the configuration and creates a new Engine for the specified network.
+// All invalid rules are reported in the returned error, the rules have to be named uniquely, since the alerts
+// are kept by the rule names.
+func NewEngine(network string, alertsConfig config.Alerts) (*Engine, error) {
+	engine := &Engine{
+		network: network,
+		rules:   make([]*Rule, 0, len(alertsConfig.Rules)),
+		alerts:  make(map[alertKey]*alert),
+	}
+
+	var mErr *multierror.Error
+
+	for _, ruleConfig := range alertsConfig.Rules {
+		rule, err := NewRule(ruleConfig, alertsConfig.RepeatInterval)
+		if err != nil {
+			mErr = multierror.Append(mErr, err)
+
+			continue
+		}
+
+		if err := engine.checkRuleName(rule.Name); err != nil {
+			mErr = multierror.Append(mErr, err)
+
+			continue
+		}
+
+		engine.rules = append(engine.rules, rule)
+	}
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return engine, nil
+}
+
+// checkRuleName checks whether the name of the rule is not taken by the rules parsed before.
+func (engine *Engine) checkRuleName(name string) error {
+	for _, rule := range engine.rules {
+		if rule.Name == name {
+			return fmt.Errorf("alert rule %q: duplicate name, the rules have to be named uniquely", name)
+		}
+	}
+
+	return nil
+}
+
+// Rules returns the parsed alert rules.
+func (engine *Engine) Rules() []*Rule {
+	return engine.rules
+}
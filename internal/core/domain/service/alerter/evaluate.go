@@ -0,0 +1,152 @@
+package alerter
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+)
+
+// Evaluate applies the rules to the hosts of the specified table and returns the events for the alerts
+// which fired, resolved or are due to be repeated at the provided time. The RPC host provides the reference values.
+// The metric rules are not evaluated for the hosts which failed to respond, their alerts keep the previous state.
+func (engine *Engine) Evaluate(table enums.TableType, hosts []host.Host, rpcHost host.Host, now time.Time) []Event {
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+
+	var events []Event
+
+	for _, rule := range engine.rules {
+		if !rule.appliesTo(table) {
+			continue
+		}
+
+		for idx := range hosts {
+			hostData := &hosts[idx]
+
+			matched, value, ok := rule.evaluate(hostData)
+			if !ok {
+				continue
+			}
+
+			key := alertKey{
+				rule:  rule.Name,
+				table: table.Alias(),
+				host:  hostData.Key(),
+			}
+
+			if event, ok := engine.transition(key, rule, matched, now); ok {
+				event.Network = engine.network
+				event.Table = table
+				event.Host = hostData.HostAddress()
+				event.Metric = rule.metricName()
+				event.Value = value
+				event.Reference = rule.reference(rpcHost)
+
+				events = append(events, event)
+			}
+		}
+	}
+
+	return events
+}
+
+// evaluate checks the rule condition for the host and returns the result with the observed value.
+// The last result is false if the rule can not be evaluated for the host.
+func (rule *Rule) evaluate(hostData *host.Host) (matched bool, value string, ok bool) {
+	if rule.isStatusRule() {
+		matched = hostData.Status == rule.Status
+		if rule.Operator == OperatorNotEqual {
+			matched = !matched
+		}
+
+		value = hostData.Status.ToLabel()
+		if value == "" {
+			value = "unknown"
+		}
+
+		return matched, value, true
+	}
+
+	if !hostData.Metrics.Updated {
+		return false, "", false
+	}
+
+	metricValue, err := hostData.Metrics.GetNumericValue(rule.Metric)
+	if err != nil {
+		return false, "", false
+	}
+
+	return rule.compare(metricValue), strconv.FormatFloat(metricValue, 'f', -1, 64), true
+}
+
+// reference returns the value of the rule metric reported by the RPC host, if any.
+func (rule *Rule) reference(rpcHost host.Host) string {
+	if rule.isStatusRule() || !rpcHost.Metrics.Updated {
+		return ""
+	}
+
+	value, err := rpcHost.Metrics.GetNumericValue(rule.Metric)
+	if err != nil || value == 0 {
+		return ""
+	}
+
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// transition updates the alert state with the result of the rule evaluation
+// and returns the event if the alert has to be reported.
+func (engine *Engine) transition(key alertKey, rule *Rule, matched bool, now time.Time) (Event, bool) {
+	state, exists := engine.alerts[key]
+
+	event := Event{
+		Rule:      rule.Name,
+		Condition: rule.Condition,
+		At:        now,
+	}
+
+	if !matched {
+		if !exists {
+			return event, false
+		}
+
+		delete(engine.alerts, key)
+
+		if !state.firing {
+			return event, false
+		}
+
+		event.State = enums.AlertStateResolved
+		event.Since = state.pendingSince
+
+		return event, true
+	}
+
+	if !exists {
+		state = &alert{pendingSince: now}
+		engine.alerts[key] = state
+	}
+
+	event.State = enums.AlertStateFiring
+	event.Since = state.pendingSince
+
+	switch {
+	case !state.firing:
+		if now.Sub(state.pendingSince) < rule.For {
+			return event, false
+		}
+
+		state.firing = true
+		state.notifiedAt = now
+
+		return event, true
+	case rule.RepeatInterval > 0 && now.Sub(state.notifiedAt) >= rule.RepeatInterval:
+		state.notifiedAt = now
+		event.Repeated = true
+
+		return event, true
+	default:
+		return event, false
+	}
+}
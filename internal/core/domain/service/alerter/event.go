@@ -0,0 +1,55 @@
+package alerter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// Event is a notification about the alert state change or a repeated notification about the firing alert.
+type Event struct {
+	Rule      string
+	Condition string
+	State     enums.AlertState
+	Repeated  bool
+	Network   string
+	Table     enums.TableType
+	Host      string
+	Metric    string
+	Value     string
+	Reference string
+	Since     time.Time
+	At        time.Time
+}
+
+// String returns a single line description of the event.
+func (event Event) String() string {
+	description := fmt.Sprintf("[%s] %s: %s %s on %s (%s), value: %s",
+		event.State, event.Network, event.Rule, event.Table.Alias(), event.Host, event.Condition, event.Value)
+
+	if event.State == enums.AlertStateFiring {
+		description += fmt.Sprintf(", firing for %s", event.At.Sub(event.Since).Round(time.Second))
+	}
+
+	return description
+}
+
+// Notification converts the event to the notification delivered by the notifiers.
+func (event Event) Notification() ports.Notification {
+	return ports.Notification{
+		State:     event.State.ToString(),
+		Repeated:  event.Repeated,
+		Rule:      event.Rule,
+		Condition: event.Condition,
+		Network:   event.Network,
+		Table:     event.Table.Alias(),
+		Host:      event.Host,
+		Metric:    event.Metric,
+		Value:     event.Value,
+		Reference: event.Reference,
+		Since:     event.Since,
+		At:        event.At,
+	}
+}
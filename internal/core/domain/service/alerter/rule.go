@@ -0,0 +1,199 @@
+package alerter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+const (
+	// statusMetric is the name used in the conditions to build the rules on the host health status.
+	statusMetric = "status"
+
+	// forKeyword separates the comparison from the duration the condition has to hold for before the alert fires.
+	forKeyword = "for"
+)
+
+type Operator string
+
+const (
+	OperatorGreater        Operator = ">"
+	OperatorGreaterOrEqual Operator = ">="
+	OperatorLess           Operator = "<"
+	OperatorLessOrEqual    Operator = "<="
+	OperatorEqual          Operator = "=="
+	OperatorNotEqual       Operator = "!="
+)
+
+// defaultTables holds the tables the rules are applied to when no tables are specified.
+var defaultTables = []enums.TableType{
+	enums.TableTypeRPC,
+	enums.TableTypeNode,
+	enums.TableTypeValidator,
+}
+
+// Rule is a parsed alert rule. Rules either compare a numeric metric against the threshold,
+// or compare the host health status against the expected status.
+type Rule struct {
+	Name           string
+	Condition      string
+	Metric         enums.MetricType
+	Operator       Operator
+	Threshold      float64
+	Status         enums.Status
+	For            time.Duration
+	RepeatInterval time.Duration
+	Tables         []enums.TableType
+}
+
+// NewRule parses the alert rule from the configuration. The default repeat interval is used
+// when the rule does not specify its own, zero repeat interval disables the repeated notifications.
+func NewRule(ruleConfig config.AlertRule, repeatInterval time.Duration) (*Rule, error) {
+	rule := &Rule{
+		Name:           strings.TrimSpace(ruleConfig.Name),
+		Condition:      strings.TrimSpace(ruleConfig.Condition),
+		RepeatInterval: repeatInterval,
+		Tables:         defaultTables,
+	}
+
+	if rule.Condition == "" {
+		return nil, fmt.Errorf("alert rule %q: condition is not provided", rule.Name)
+	}
+
+	if rule.Name == "" {
+		rule.Name = rule.Condition
+	}
+
+	if ruleConfig.RepeatInterval != nil {
+		rule.RepeatInterval = *ruleConfig.RepeatInterval
+	}
+
+	if rule.RepeatInterval < 0 {
+		return nil, fmt.Errorf("alert rule %q: invalid repeat interval: %s", rule.Name, rule.RepeatInterval)
+	}
+
+	if err := rule.parseCondition(); err != nil {
+		return nil, fmt.Errorf("alert rule %q: %w", rule.Name, err)
+	}
+
+	if len(ruleConfig.Tables) > 0 {
+		rule.Tables = make([]enums.TableType, 0, len(ruleConfig.Tables))
+
+		for _, alias := range ruleConfig.Tables {
+			table, ok := enums.TableTypeFromAlias(alias)
+			if !ok {
+				return nil, fmt.Errorf("alert rule %q: unsupported table %q", rule.Name, alias)
+			}
+
+			rule.Tables = append(rule.Tables, table)
+		}
+	}
+
+	return rule, nil
+}
+
+// parseCondition parses the rule condition in the format "<metric> <operator> <value> [for <duration>]".
+func (rule *Rule) parseCondition() error {
+	fields := strings.Fields(rule.Condition)
+
+	switch {
+	case len(fields) == 5 && strings.EqualFold(fields[3], forKeyword):
+		duration, err := time.ParseDuration(fields[4])
+		if err != nil || duration < 0 {
+			return fmt.Errorf("invalid duration %q in condition %q", fields[4], rule.Condition)
+		}
+
+		rule.For = duration
+	case len(fields) != 3:
+		return fmt.Errorf("invalid condition %q, expected format: <metric> <operator> <value> [for <duration>]", rule.Condition)
+	}
+
+	metricName, operator, value := fields[0], Operator(fields[1]), fields[2]
+
+	switch operator {
+	case OperatorGreater, OperatorGreaterOrEqual, OperatorLess, OperatorLessOrEqual, OperatorEqual, OperatorNotEqual:
+		rule.Operator = operator
+	default:
+		return fmt.Errorf("unsupported operator %q in condition %q", operator, rule.Condition)
+	}
+
+	if strings.EqualFold(metricName, statusMetric) {
+		if operator != OperatorEqual && operator != OperatorNotEqual {
+			return fmt.Errorf("unsupported operator %q for status in condition %q, use == or !=", operator, rule.Condition)
+		}
+
+		status, ok := enums.StatusFromLabel(value)
+		if !ok {
+			return fmt.Errorf("unsupported status %q in condition %q, use green, yellow, red or grey", value, rule.Condition)
+		}
+
+		rule.Status = status
+
+		return nil
+	}
+
+	rule.Metric = enums.MetricTypeFromName(metricName)
+
+	if _, err := metrics.NewMetrics().GetNumericValue(rule.Metric); err != nil {
+		return fmt.Errorf("unsupported metric %q in condition %q", metricName, rule.Condition)
+	}
+
+	threshold, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("invalid threshold %q in condition %q", value, rule.Condition)
+	}
+
+	rule.Threshold = threshold
+
+	return nil
+}
+
+// appliesTo checks whether the rule has to be evaluated for the hosts of the specified table.
+func (rule *Rule) appliesTo(table enums.TableType) bool {
+	for _, ruleTable := range rule.Tables {
+		if ruleTable == table {
+			return true
+		}
+	}
+
+	return false
+}
+
+// metricName returns the name of the metric the rule is built on.
+func (rule *Rule) metricName() string {
+	if rule.isStatusRule() {
+		return statusMetric
+	}
+
+	return rule.Metric.Alias()
+}
+
+// isStatusRule checks whether the rule is built on the host health status.
+func (rule *Rule) isStatusRule() bool {
+	return rule.Status != ""
+}
+
+// compare applies the rule operator to the provided value and the threshold.
+func (rule *Rule) compare(value float64) bool {
+	switch rule.Operator {
+	case OperatorGreater:
+		return value > rule.Threshold
+	case OperatorGreaterOrEqual:
+		return value >= rule.Threshold
+	case OperatorLess:
+		return value < rule.Threshold
+	case OperatorLessOrEqual:
+		return value <= rule.Threshold
+	case OperatorEqual:
+		return value == rule.Threshold
+	case OperatorNotEqual:
+		return value != rule.Threshold
+	default:
+		return false
+	}
+}
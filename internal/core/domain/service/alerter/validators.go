@@ -0,0 +1,233 @@
+package alerter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+const (
+	// slashingPercentageDefault is the slashing percentage the reports on the tracked validators are alerted on by default.
+	slashingPercentageDefault = 50
+
+	ruleValidatorReported = "validator-reported"
+	ruleValidatorSlashing = "validator-slashing"
+	ruleValidatorAtRisk   = "validator-at-risk"
+)
+
+type (
+	// ValidatorWatcher compares the reports and the at risk records of the tracked validators between the polls and returns
+	// the events when a validator gets newly reported, crosses the slashing percentage threshold or enters or leaves the validators at risk.
+	ValidatorWatcher struct {
+		lock sync.Mutex
+
+		network            string
+		slashingPercentage float64
+		validators         []config.Validator
+		states             map[string]*validatorState
+	}
+
+	// validatorState holds the reports and the at risk record of a tracked validator observed on the last poll.
+	validatorState struct {
+		reporters     map[string]bool
+		reportedSince time.Time
+		slashing      bool
+		slashingSince time.Time
+		atRisk        bool
+		atRiskSince   time.Time
+	}
+)
+
+// NewValidatorWatcher creates a new ValidatorWatcher for the validators configured with their Sui address or name.
+// The default slashing percentage threshold is used if the alerts section does not specify one.
+func NewValidatorWatcher(network string, alertsConfig config.Alerts, validatorsConfig []config.Validator) *ValidatorWatcher {
+	watcher := &ValidatorWatcher{
+		network:            network,
+		slashingPercentage: slashingPercentageDefault,
+		states:             make(map[string]*validatorState),
+	}
+
+	if alertsConfig.SlashingPercentage != nil {
+		watcher.slashingPercentage = *alertsConfig.SlashingPercentage
+	}
+
+	for _, validator := range validatorsConfig {
+		if validator.SuiAddress == "" && validator.Name == "" {
+			continue
+		}
+
+		watcher.validators = append(watcher.validators, validator)
+	}
+
+	return watcher
+}
+
+// Validators returns the number of the tracked validators.
+func (watcher *ValidatorWatcher) Validators() int {
+	return len(watcher.validators)
+}
+
+// Watch compares the reports and the at risk records of the tracked validators in the system state with the ones observed
+// on the previous poll and returns the events for the changes. The validators which are not found among the active validators keep their state.
+func (watcher *ValidatorWatcher) Watch(systemState *metrics.SuiSystemState, now time.Time) []Event {
+	watcher.lock.Lock()
+	defer watcher.lock.Unlock()
+
+	var events []Event
+
+	for _, validatorConfig := range watcher.validators {
+		validator := systemState.LookupValidator(validatorConfig.SuiAddress, validatorConfig.Name)
+		if validator == nil {
+			continue
+		}
+
+		state, ok := watcher.states[validator.SuiAddress]
+		if !ok {
+			state = &validatorState{reporters: make(map[string]bool)}
+			watcher.states[validator.SuiAddress] = state
+		}
+
+		validatorEvents := watcher.watchReports(state, systemState, validator.SuiAddress, now)
+		validatorEvents = append(validatorEvents, watcher.watchAtRisk(state, systemState, validator.SuiAddress, now)...)
+
+		for idx := range validatorEvents {
+			validatorEvents[idx].Network = watcher.network
+			validatorEvents[idx].Host = fmt.Sprintf("%s (%s)", validator.Name, validator.SuiAddress)
+			validatorEvents[idx].At = now
+		}
+
+		events = append(events, validatorEvents...)
+	}
+
+	return events
+}
+
+// watchReports returns the events for the new reports on the validator and for the slashing percentage crossing the threshold.
+// The reports are resolved once all of them are withdrawn or reset on the epoch change.
+func (watcher *ValidatorWatcher) watchReports(state *validatorState, systemState *metrics.SuiSystemState, address string, now time.Time) []Event {
+	var events []Event
+
+	report, reported := systemState.LookupValidatorReport(address)
+
+	reporters := make(map[string]bool, len(report.Reporters))
+	newReporters := make([]string, 0, len(report.Reporters))
+
+	for _, reporter := range report.Reporters {
+		reporters[reporter.Address] = true
+
+		if !state.reporters[reporter.Address] {
+			newReporters = append(newReporters, reporter.Name)
+		}
+	}
+
+	switch {
+	case len(newReporters) > 0:
+		if len(state.reporters) == 0 {
+			state.reportedSince = now
+		}
+
+		events = append(events, Event{
+			Rule:      ruleValidatorReported,
+			Condition: "reported by " + strings.Join(newReporters, ", "),
+			State:     enums.AlertStateFiring,
+			Repeated:  len(state.reporters) > 0,
+			Table:     enums.TableTypeValidatorReports,
+			Metric:    "reporters",
+			Value:     formatReporters(report.Reporters),
+			Since:     state.reportedSince,
+		})
+	case len(reporters) == 0 && len(state.reporters) > 0:
+		events = append(events, Event{
+			Rule:      ruleValidatorReported,
+			Condition: "reports withdrawn",
+			State:     enums.AlertStateResolved,
+			Table:     enums.TableTypeValidatorReports,
+			Metric:    "reporters",
+			Value:     "none",
+			Since:     state.reportedSince,
+		})
+	}
+
+	state.reporters = reporters
+
+	slashing := reported && report.SlashingPercentage > watcher.slashingPercentage
+	if slashing == state.slashing {
+		return events
+	}
+
+	event := Event{
+		Rule:      ruleValidatorSlashing,
+		Condition: fmt.Sprintf("slashing-percentage > %s", strconv.FormatFloat(watcher.slashingPercentage, 'f', -1, 64)),
+		State:     enums.AlertStateResolved,
+		Table:     enums.TableTypeValidatorReports,
+		Metric:    "slashing-percentage",
+		Value:     fmt.Sprintf("%.2f", report.SlashingPercentage),
+		Since:     state.slashingSince,
+	}
+
+	if slashing {
+		state.slashingSince = now
+
+		event.State = enums.AlertStateFiring
+		event.Since = now
+	}
+
+	state.slashing = slashing
+
+	return append(events, event)
+}
+
+// watchAtRisk returns the event for the validator entering or leaving the validators at risk.
+func (watcher *ValidatorWatcher) watchAtRisk(state *validatorState, systemState *metrics.SuiSystemState, address string, now time.Time) []Event {
+	validatorAtRisk, atRisk := systemState.LookupValidatorAtRisk(address)
+	if atRisk == state.atRisk {
+		return nil
+	}
+
+	event := Event{
+		Rule:      ruleValidatorAtRisk,
+		Condition: "at risk",
+		State:     enums.AlertStateResolved,
+		Table:     enums.TableTypeValidatorsAtRisk,
+		Metric:    "epochs-at-risk",
+		Value:     "0",
+		Since:     state.atRiskSince,
+	}
+
+	if atRisk {
+		state.atRiskSince = now
+
+		event.State = enums.AlertStateFiring
+		event.Value = validatorAtRisk.EpochsAtRisk
+		event.Since = now
+	}
+
+	state.atRisk = atRisk
+
+	return []Event{event}
+}
+
+// formatReporters returns the reporters ordered by their voting power, formatted as "name (voting power N)".
+func formatReporters(reporters []metrics.ValidatorReporter) string {
+	sorted := make([]metrics.ValidatorReporter, len(reporters))
+	copy(sorted, reporters)
+
+	sort.SliceStable(sorted, func(left, right int) bool {
+		return sorted[left].VotingPower > sorted[right].VotingPower
+	})
+
+	formatted := make([]string, 0, len(sorted))
+
+	for _, reporter := range sorted {
+		formatted = append(formatted, fmt.Sprintf("%s (voting power %d)", reporter.Name, reporter.VotingPower))
+	}
+
+	return strings.Join(formatted, ", ")
+}
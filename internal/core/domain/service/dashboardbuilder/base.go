@@ -4,33 +4,89 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/mum4k/termdash/container"
 	"github.com/mum4k/termdash/keyboard"
 	"github.com/mum4k/termdash/terminal/termbox"
 	"github.com/mum4k/termdash/terminal/terminalapi"
+	"github.com/mum4k/termdash/widgets/text"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/core/domain/host"
 	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
 )
 
 type Builder struct {
-	ctx        context.Context
-	tableType  enums.TableType
-	cliGateway *cligw.Gateway
-	terminal   *termbox.Terminal
-	dashboard  *container.Container
-	host       host.Host
-	cells      dashboards.Cells
-	quitter    func(k *terminalapi.Keyboard)
+	ctx          context.Context
+	lock         sync.Mutex
+	tableType    enums.TableType
+	cliGateway   *cligw.Gateway
+	subscription ports.SubscriptionGateway
+	terminal     *termbox.Terminal
+	dashboard    *container.Container
+	host         host.Host
+	reference    host.Host
+	fallbacks    []host.Host
+	cells        dashboards.Cells
+	quitter      func(k *terminalapi.Keyboard)
+
+	// pushedAt holds the time the counters of the host were last sampled on a notification of the subscription.
+	pushedAt time.Time
+
+	// referencedAt holds the time of the last update of the reference host metrics.
+	referencedAt time.Time
+
+	// generation is incremented once the dashboard is switched or the polled state is stored,
+	// so the results of the polls copying the state before are dropped.
+	generation int
+
+	// epoch holds the current epoch the epochs history of the host was last requested in.
+	epoch string
+
+	// fleet and tiles hold the hosts rendered on the fleet dashboard and their tiles, in the same order.
+	fleet []host.Host
+	tiles []*dashboards.FleetTile
+
+	// dashboards holds the dashboards the keyboard switches between, dashboardIdx points to the rendered one.
+	// They are changed holding both db.lock and db.viewLock, so either of the locks is enough to read them.
+	dashboards   []Dashboard
+	dashboardIdx int
+
+	// viewLock guards the layout of the dashboard, which is rebuilt once the dashboard is switched, and the help
+	// shown in place of it. viewErr holds the error of the last switch, which stops the rendering.
+	viewLock  sync.Mutex
+	layout    []container.Option
+	help      *text.Text
+	helpShown bool
+	viewErr   error
+
+	// layouts holds the layouts of the configuration the dashboards are laid out with instead of the built-in ones.
+	layouts map[enums.TableType]dashboards.Layout
+
+	// subscribedKey holds the key of the host the subscription is opened for, the notifications are ignored
+	// while the dashboard renders another host or dashboard type.
+	subscribedKey string
+	subscribedTo  enums.TableType
 }
 
 // NewBuilder creates a new Builder instance with the provided CLI gateway.
 // It initializes the termbox terminal and dashboard, and sets up a context and quitter function.
+// The health of the host is checked against the reference RPC host on every update of its metrics.
+// The fallback hosts are ranked by their progress when the host fails to respond, so the dashboard keeps being updated.
+// If the subscription gateway is provided, the counters of the host are also sampled on the notifications of the host.
 // If an error occurs during initialization, it returns an error.
-func NewBuilder(tableType enums.TableType, host host.Host, cliGateway *cligw.Gateway) (*Builder, error) {
+func NewBuilder(
+	tableType enums.TableType,
+	host host.Host,
+	reference host.Host,
+	fallbacks []host.Host,
+	subscription ports.SubscriptionGateway,
+	cliGateway *cligw.Gateway,
+) (*Builder, error) {
 	terminal, err := termbox.New()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize termbox terminal: %w", err)
@@ -39,11 +95,16 @@ func NewBuilder(tableType enums.TableType, host host.Host, cliGateway *cligw.Gat
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &Builder{
-		ctx:        ctx,
-		tableType:  tableType,
-		cliGateway: cliGateway,
-		terminal:   terminal,
-		host:       host,
+		ctx:           ctx,
+		tableType:     tableType,
+		cliGateway:    cliGateway,
+		subscription:  subscription,
+		terminal:      terminal,
+		host:          host,
+		reference:     reference,
+		fallbacks:     fallbacks,
+		subscribedKey: host.Key(),
+		subscribedTo:  tableType,
 		quitter: func(k *terminalapi.Keyboard) {
 			if k.Key == 'q' || k.Key == 'Q' || k.Key == keyboard.KeyEsc || k.Key == keyboard.KeyCtrlC {
 				terminal.Close()
@@ -0,0 +1,239 @@
+package dashboards
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mum4k/termdash/cell"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+const (
+	// topStakeValidators is the number of the validators with the highest stake the stake share is calculated for.
+	topStakeValidators = 10
+
+	// rankingNameLength is the maximum length of the validator name in the validators ranking.
+	rankingNameLength = 30
+
+	rankingLineFormat = "%-6v%-32v%14v%20v%12v%12v%10v\n"
+)
+
+var (
+	ColumnsConfigActiveValidators = ColumnsConfig{
+		// Overview section
+		enums.ColumnNameSystemActiveValidatorCount:          16,
+		enums.ColumnNameSystemTotalStake:                    17,
+		enums.ColumnNameActiveValidatorsNakamotoCoefficient: 16,
+		enums.ColumnNameActiveValidatorsTopStakeShare:       17,
+		enums.ColumnNameActiveValidatorsAverageApy:          16,
+		enums.ColumnNameSystemEstimatedReferenceGasPrice:    17,
+
+		// Charts section
+		enums.ColumnNameActiveValidatorsVotingPowerChart: 99,
+		enums.ColumnNameActiveValidatorsGasPriceSurvey:   99,
+
+		// Ranking section
+		enums.ColumnNameActiveValidatorsRanking: 99,
+	}
+
+	RowsConfigActiveValidators = RowsConfig{
+		0: {
+			Height: 14,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameSystemActiveValidatorCount,
+				enums.ColumnNameSystemTotalStake,
+				enums.ColumnNameActiveValidatorsNakamotoCoefficient,
+				enums.ColumnNameActiveValidatorsTopStakeShare,
+				enums.ColumnNameActiveValidatorsAverageApy,
+				enums.ColumnNameSystemEstimatedReferenceGasPrice,
+			},
+		},
+		1: {
+			Height: 22,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameActiveValidatorsVotingPowerChart,
+			},
+		},
+		2: {
+			Height: 22,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameActiveValidatorsGasPriceSurvey,
+			},
+		},
+		3: {
+			Height: 41,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameActiveValidatorsRanking,
+			},
+		},
+	}
+
+	CellsConfigActiveValidators = CellsConfig{
+		enums.ColumnNameSystemActiveValidatorCount:          {"ACTIVE VALIDATORS", cell.ColorGreen},
+		enums.ColumnNameSystemTotalStake:                    {"TOTAL STAKE, SUI", cell.ColorBlue},
+		enums.ColumnNameActiveValidatorsNakamotoCoefficient: {"NAKAMOTO COEFFICIENT", cell.ColorRed},
+		enums.ColumnNameActiveValidatorsTopStakeShare:       {"TOP 10 VALIDATORS STAKE SHARE, %", cell.ColorRed},
+		enums.ColumnNameActiveValidatorsAverageApy:          {"AVERAGE APY, %", cell.ColorGreen},
+		enums.ColumnNameSystemEstimatedReferenceGasPrice:    {"ESTIMATED REFERENCE GAS PRICE", cell.ColorYellow},
+		enums.ColumnNameActiveValidatorsVotingPowerChart:    {"VOTING POWER DISTRIBUTION, NAKAMOTO SET IN RED", cell.ColorBlue},
+		enums.ColumnNameActiveValidatorsGasPriceSurvey:      {"NEXT EPOCH GAS PRICE SURVEY, 2/3 QUORUM VALIDATOR IN YELLOW", cell.ColorYellow},
+		enums.ColumnNameActiveValidatorsRanking:             {"VALIDATORS RANKING BY VOTING POWER, SCROLL WITH MOUSE WHEEL", cell.ColorGreen},
+	}
+)
+
+// BarChartSeries holds the values of the bar chart, which replace the values rendered previously, and the colors of the bars.
+type BarChartSeries struct {
+	Values []int
+	Colors []cell.Color
+}
+
+// GetActiveValidatorsColumnValues returns the values of the active validators set on the specified host.
+// The values are calculated from the latest system state and the validators APYs of the host, the values are left empty
+// until the system state is received.
+func GetActiveValidatorsColumnValues(host host.Host) (ColumnValues, error) {
+	activeValidators := host.Metrics.SystemState.ActiveValidators
+	if len(activeValidators) == 0 {
+		return ColumnValues{
+			enums.ColumnNameSystemActiveValidatorCount:          "",
+			enums.ColumnNameSystemTotalStake:                    "",
+			enums.ColumnNameActiveValidatorsNakamotoCoefficient: "",
+			enums.ColumnNameActiveValidatorsTopStakeShare:       "",
+			enums.ColumnNameActiveValidatorsAverageApy:          "",
+			enums.ColumnNameSystemEstimatedReferenceGasPrice:    "",
+			enums.ColumnNameActiveValidatorsVotingPowerChart:    BarChartSeries{},
+			enums.ColumnNameActiveValidatorsGasPriceSurvey:      BarChartSeries{},
+			enums.ColumnNameActiveValidatorsRanking:             "",
+		}, nil
+	}
+
+	totalStake, err := domainmetrics.MistToSui(host.Metrics.SystemState.TotalStake)
+	if err != nil {
+		return nil, err
+	}
+
+	votingPowers, nakamotoCoefficient, err := activeValidators.GetVotingPowerDistribution()
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrices, quorumIndex, err := activeValidators.GetGasPriceSurvey()
+	if err != nil {
+		return nil, err
+	}
+
+	topStakeShare, err := activeValidators.GetStakeShare(topStakeValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	ranking, err := newValidatorsRanking(activeValidators, host.Metrics.ValidatorsApyParsed)
+	if err != nil {
+		return nil, err
+	}
+
+	var apySum float64
+
+	for _, validator := range activeValidators {
+		apySum += host.Metrics.ValidatorsApyParsed[validator.SuiAddress]
+	}
+
+	votingPowerColors := make([]cell.Color, len(votingPowers))
+	for idx := range votingPowerColors {
+		votingPowerColors[idx] = cell.ColorBlue
+		if idx < nakamotoCoefficient {
+			votingPowerColors[idx] = cell.ColorRed
+		}
+	}
+
+	gasPriceColors := make([]cell.Color, len(gasPrices))
+	for idx := range gasPriceColors {
+		switch {
+		case idx < quorumIndex:
+			gasPriceColors[idx] = cell.ColorGreen
+		case idx == quorumIndex:
+			gasPriceColors[idx] = cell.ColorYellow
+		default:
+			gasPriceColors[idx] = cell.ColorRed
+		}
+	}
+
+	return ColumnValues{
+		enums.ColumnNameSystemActiveValidatorCount:          len(activeValidators),
+		enums.ColumnNameSystemTotalStake:                    totalStake,
+		enums.ColumnNameActiveValidatorsNakamotoCoefficient: nakamotoCoefficient,
+		enums.ColumnNameActiveValidatorsTopStakeShare:       fmt.Sprintf("%.2f", topStakeShare),
+		enums.ColumnNameActiveValidatorsAverageApy:          fmt.Sprintf("%.2f", apySum/float64(len(activeValidators))*100),
+		enums.ColumnNameSystemEstimatedReferenceGasPrice:    host.Metrics.EstimatedNextReferenceGasPrice,
+		enums.ColumnNameActiveValidatorsVotingPowerChart:    BarChartSeries{Values: votingPowers, Colors: votingPowerColors},
+		enums.ColumnNameActiveValidatorsGasPriceSurvey:      BarChartSeries{Values: gasPrices, Colors: gasPriceColors},
+		enums.ColumnNameActiveValidatorsRanking:             ranking,
+	}, nil
+}
+
+// newValidatorsRanking renders the list of the validators ranked by the voting power, the validators with equal
+// voting power are ranked by the next epoch stake and the name. The validators are sorted on a copy of the list,
+// so the order of the system state validators is kept.
+func newValidatorsRanking(validators domainmetrics.Validators, validatorsApy domainmetrics.ValidatorsApyParsed) (string, error) {
+	type rankedValidator struct {
+		validator   *domainmetrics.Validator
+		votingPower int
+		stake       int64
+	}
+
+	ranked := make([]rankedValidator, 0, len(validators))
+
+	for _, validator := range validators {
+		votingPower, err := strconv.Atoi(validator.VotingPower)
+		if err != nil {
+			return "", fmt.Errorf("unexpected metric value type for VotingPower: %s", validator.VotingPower)
+		}
+
+		stake, err := domainmetrics.MistToSui(validator.NextEpochStake)
+		if err != nil {
+			return "", err
+		}
+
+		ranked = append(ranked, rankedValidator{validator: validator, votingPower: votingPower, stake: stake})
+	}
+
+	sort.SliceStable(ranked, func(left, right int) bool {
+		if ranked[left].votingPower != ranked[right].votingPower {
+			return ranked[left].votingPower > ranked[right].votingPower
+		}
+
+		if ranked[left].stake != ranked[right].stake {
+			return ranked[left].stake > ranked[right].stake
+		}
+
+		return ranked[left].validator.Name < ranked[right].validator.Name
+	})
+
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf(rankingLineFormat, "RANK", "NAME", "VOTING POWER", "NEXT EPOCH STAKE", "GAS PRICE", "COMMISSION", "APY, %"))
+
+	for idx, validator := range ranked {
+		name := validator.validator.Name
+		if len([]rune(name)) > rankingNameLength {
+			name = string([]rune(name)[:rankingNameLength])
+		}
+
+		builder.WriteString(fmt.Sprintf(
+			rankingLineFormat,
+			idx+1,
+			name,
+			validator.votingPower,
+			validator.stake,
+			validator.validator.NextEpochGasPrice,
+			validator.validator.CommissionRate,
+			fmt.Sprintf("%.3f", validatorsApy[validator.validator.SuiAddress]*100),
+		))
+	}
+
+	return builder.String(), nil
+}
@@ -14,7 +14,8 @@ import (
 )
 
 const (
-	dashboardName  = "💧 SUIMON: PRESS Q or ESC TO QUIT"
+	dashboardName  = "💧 SUIMON"
+	dashboardHint  = "PRESS H FOR HELP, Q OR ESC TO QUIT"
 	emptyRowHeight = 1
 )
 
@@ -22,7 +23,7 @@ var (
 	DashboardConfigDefault = []container.Option{
 		container.Border(linestyle.Light),
 		container.BorderColor(cell.ColorGreen),
-		container.BorderTitle(dashboardName),
+		container.BorderTitle(dashboardName + ": " + dashboardHint),
 		container.FocusedColor(cell.ColorGreen),
 		container.AlignHorizontal(align.HorizontalCenter),
 		container.AlignVertical(align.VerticalMiddle),
@@ -40,6 +41,16 @@ var (
 	}
 )
 
+// DashboardTitle returns the title of the dashboard naming the dashboard type and the host it renders.
+// The fleet dashboard renders all its hosts at once, so only its type is named.
+func DashboardTitle(dashboard enums.TableType, host domainhost.Host) string {
+	if dashboard == enums.TableTypeFleet {
+		return fmt.Sprintf("%s: %s | %s", dashboardName, dashboard, dashboardHint)
+	}
+
+	return fmt.Sprintf("%s: %s %s | %s", dashboardName, dashboard, host.HostAddress(), dashboardHint)
+}
+
 // GetColumnsConfig returns the columns configuration based on the specified dashboard type.
 func GetColumnsConfig(dashboard enums.TableType) (ColumnsConfig, error) {
 	switch dashboard {
@@ -51,6 +62,10 @@ func GetColumnsConfig(dashboard enums.TableType) (ColumnsConfig, error) {
 		return ColumnsConfigRPC, nil
 	case enums.TableTypeGasPriceAndSubsidy:
 		return ColumnsConfigSystemState, nil
+	case enums.TableTypeEpochsHistory:
+		return ColumnsConfigEpochsHistory, nil
+	case enums.TableTypeActiveValidators:
+		return ColumnsConfigActiveValidators, nil
 	default:
 		return nil, fmt.Errorf("unknown dashboard type: %v", dashboard)
 	}
@@ -67,6 +82,10 @@ func GetColumnsValues(dashboard enums.TableType, host domainhost.Host) (ColumnVa
 		return GetRPCColumnValues(host), nil
 	case enums.TableTypeGasPriceAndSubsidy:
 		return GeSystemStateColumnValues(host)
+	case enums.TableTypeEpochsHistory:
+		return GetEpochsHistoryColumnValues(host)
+	case enums.TableTypeActiveValidators:
+		return GetActiveValidatorsColumnValues(host)
 	default:
 		return nil, fmt.Errorf("unknown dashboard type: %v", dashboard)
 	}
@@ -83,6 +102,10 @@ func GetRowsConfig(dashboard enums.TableType) (RowsConfig, error) {
 		return RowsConfigRPC, nil
 	case enums.TableTypeGasPriceAndSubsidy:
 		return RowsConfigSystemState, nil
+	case enums.TableTypeEpochsHistory:
+		return RowsConfigEpochsHistory, nil
+	case enums.TableTypeActiveValidators:
+		return RowsConfigActiveValidators, nil
 	default:
 		return nil, fmt.Errorf("unknown dashboard type: %v", dashboard)
 	}
@@ -99,6 +122,10 @@ func GetCellsConfig(dashboard enums.TableType) (CellsConfig, error) {
 		return CellsConfigRPC, nil
 	case enums.TableTypeGasPriceAndSubsidy:
 		return CellsConfigSystemState, nil
+	case enums.TableTypeEpochsHistory:
+		return CellsConfigEpochsHistory, nil
+	case enums.TableTypeActiveValidators:
+		return CellsConfigActiveValidators, nil
 	default:
 		return nil, fmt.Errorf("unknown dashboard type: %v", dashboard)
 	}
@@ -154,14 +181,14 @@ func GetRows(rowsConfig RowsConfig, cells Cells, columns Columns) (Rows, error)
 
 // GetCells creates a new set of cells based on the configuration provided.
 // It accepts a CellsConfig object that maps column names to cell names,
-// and a terminal object that represents the terminal where the cells will be displayed.
+// and a WidgetsConfig object that maps column names to the types of the widgets rendering them.
 // It returns a Cells object and an error. The Cells object is a map that maps
 // column names to cell objects.
-func GetCells(cellsConfig CellsConfig) (Cells, error) {
+func GetCells(cellsConfig CellsConfig, widgets WidgetsConfig) (Cells, error) {
 	cells := make(Cells, len(cellsConfig))
 
 	for columnName, cellConfig := range cellsConfig {
-		widget, err := newWidgetByColumnName(columnName, cellConfig.Color)
+		widget, err := newWidgetByColumnName(columnName, widgets.WidgetType(columnName), cellConfig.Color)
 		if err != nil {
 			return nil, err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/mum4k/termdash/container"
 	"github.com/mum4k/termdash/container/grid"
 	"github.com/mum4k/termdash/widgetapi"
+	"github.com/mum4k/termdash/widgets/barchart"
 	"github.com/mum4k/termdash/widgets/gauge"
 	"github.com/mum4k/termdash/widgets/segmentdisplay"
 	"github.com/mum4k/termdash/widgets/sparkline"
@@ -29,14 +30,22 @@ type (
 	}
 )
 
+// TextChunk is a part of the text widget value written in its own color, the default color is used if the color is not set.
+type TextChunk struct {
+	Text  string
+	Color cell.Color
+}
+
 // Cells is a type that represents a mapping of column names to pointers to Cell structs.
 type Cells map[enums.ColumnName]*Cell
 
 // Cell is a struct that represents a single cell in a dashboard grid. It contains a widget and a list of options.
+// LastValue holds the last value written to the text widget, so the unchanged text is not rewritten and its scroll position is kept.
 type Cell struct {
 	Widget        widgetapi.Widget
 	Options       []container.Option
 	LastUpdatedAt time.Time
+	LastValue     any
 }
 
 // NewCell is a function that creates a new Cell struct given a cellName and a widget. It returns a pointer to the new Cell and an error (if any).
@@ -68,6 +77,12 @@ func (c *Cell) Write(value any) error {
 	case *gauge.Gauge:
 		return writeToGaugeWidget(widget, value)
 	case *text.Text:
+		if valueString, ok := value.(string); ok && c.LastValue == valueString {
+			return nil
+		}
+
+		c.LastValue = value
+
 		return writeToTextWidget(widget, value)
 	case *segmentdisplay.SegmentDisplay:
 		return writeToSegmentWidget(widget, value)
@@ -79,30 +94,59 @@ func (c *Cell) Write(value any) error {
 		c.LastUpdatedAt = now
 
 		return writeToSparkLineWidget(widget, value)
+	case *barchart.BarChart:
+		return writeToBarChartWidget(widget, value)
 	}
 
 	return nil
 }
 
 // writeToTextWidget writes a string value to a text widget with the given options.
-// The function expects a slice of cell options and a value of type string,
-// and returns an error if the value has a different type. The function uses
+// The function expects a value of type string or a slice of text chunks, the integers and the slices of strings
+// written to the segment displays are accepted as well, and returns an error if the value has a different type. The function uses
 // the `text.Text` type and its `Write` method to write the string value to the widget,
-// with the options converted to `text.WriteOption` using the `text.WriteCellOpts` function.
+// the chunks are written one after another in their colors, the first one replacing the previous content.
 // The function removes any non-printable characters from the string value before writing it
-// to the widget, and returns immediately if the resulting string has zero length.
+// to the widget. The value replaces the previous content of the widget, the widget is cleared
+// if the resulting string has zero length.
 func writeToTextWidget(widget *text.Text, value any) error {
-	valueString, ok := value.(string)
-	if !ok {
+	if chunks, ok := value.([]TextChunk); ok {
+		for idx, chunk := range chunks {
+			options := []text.WriteOption{text.WriteCellOpts(cell.FgColor(chunk.Color))}
+			if idx == 0 {
+				options = append(options, text.WriteReplace())
+			}
+
+			if err := widget.Write(chunk.Text, options...); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var valueString string
+
+	// the values of the segment display cells are written as they are displayed, in case the cell is rendered with the text widget
+	switch typedValue := value.(type) {
+	case string:
+		valueString = typedValue
+	case []string:
+		valueString = strings.Join(typedValue, " ")
+	case int, int64:
+		valueString = fmt.Sprint(typedValue)
+	default:
 		return fmt.Errorf("invalid value type for text widget: %T", value)
 	}
 
 	valueString = log.RemoveNonPrintableChars(valueString)
 	if len(valueString) == 0 {
+		widget.Reset()
+
 		return nil
 	}
 
-	return widget.Write(valueString)
+	return widget.Write(valueString, text.WriteReplace())
 }
 
 // writeToGaugeWidget writes a value to a gauge widget.
@@ -123,16 +167,45 @@ func writeToGaugeWidget(widget *gauge.Gauge, value any) error {
 }
 
 // writeToSparkLineWidget adds a new value to a sparkline chart widget.
-// The function expects an integer value and returns an error if the value
+// The function expects an integer value or a series of values and returns an error if the value
 // has a different type. It uses the `widget` argument to add the new value
 // to the chart using the `Add` method of the `sparkline.SparkLine` type.
+// The series replaces the values added to the chart previously.
 func writeToSparkLineWidget(widget *sparkline.SparkLine, value any) error {
-	valueInt, ok := value.(int)
-	if !ok {
+	switch typedValue := value.(type) {
+	case int:
+		return widget.Add([]int{typedValue})
+	case SparkLineSeries:
+		widget.Clear()
+
+		if len(typedValue.Values) == 0 {
+			return nil
+		}
+
+		return widget.Add(typedValue.Values, sparkline.Label(typedValue.Label))
+	default:
 		return fmt.Errorf("unexpected metric value type for sparkline widget: %T", value)
 	}
+}
+
+// writeToBarChartWidget replaces the values of a bar chart widget with the series of values.
+// The function expects a series of values and returns an error if the value has a different type.
+// The bars are scaled to the maximum value of the series and colored with the colors of the series.
+func writeToBarChartWidget(widget *barchart.BarChart, value any) error {
+	series, ok := value.(BarChartSeries)
+	if !ok {
+		return fmt.Errorf("unexpected metric value type for barchart widget: %T", value)
+	}
+
+	maxValue := 1
 
-	return widget.Add([]int{valueInt})
+	for _, barValue := range series.Values {
+		if barValue > maxValue {
+			maxValue = barValue
+		}
+	}
+
+	return widget.Values(series.Values, maxValue, barchart.BarColors(series.Colors))
 }
 
 // writeToSegmentWidget writes a value to a segment display widget.
@@ -143,6 +216,11 @@ func writeToSparkLineWidget(widget *sparkline.SparkLine, value any) error {
 func writeToSegmentWidget(widget *segmentdisplay.SegmentDisplay, value any) error {
 	capacity := widget.Capacity()
 
+	// the capacity is not known until the widget is drawn, the loading value can not be sized until then
+	if capacity == 0 {
+		return nil
+	}
+
 	var chunks []*segmentdisplay.TextChunk
 
 	switch v := value.(type) {
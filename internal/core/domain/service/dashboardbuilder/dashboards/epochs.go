@@ -0,0 +1,180 @@
+package dashboards
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/mum4k/termdash/cell"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+	domainmetrics "github.com/bartosian/suimon/internal/core/domain/metrics"
+)
+
+var (
+	ColumnsConfigEpochsHistory = ColumnsConfig{
+		// Last epoch section
+		enums.ColumnNameEpoch:                  33,
+		enums.ColumnNameEpochTotalTransactions: 33,
+		enums.ColumnNameEpochReferenceGasPrice: 33,
+
+		// Charts section
+		enums.ColumnNameEpochTotalTransactionsChart:            99,
+		enums.ColumnNameEpochTotalGasFeesChart:                 99,
+		enums.ColumnNameEpochTotalStakeRewardsDistributedChart: 99,
+		enums.ColumnNameEpochStorageFundBalanceChart:           99,
+		enums.ColumnNameEpochReferenceGasPriceChart:            99,
+	}
+
+	RowsConfigEpochsHistory = RowsConfig{
+		0: {
+			Height: 19,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameEpoch,
+				enums.ColumnNameEpochTotalTransactions,
+				enums.ColumnNameEpochReferenceGasPrice,
+			},
+		},
+		1: {
+			Height: 16,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameEpochTotalTransactionsChart,
+			},
+		},
+		2: {
+			Height: 16,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameEpochTotalGasFeesChart,
+			},
+		},
+		3: {
+			Height: 16,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameEpochTotalStakeRewardsDistributedChart,
+			},
+		},
+		4: {
+			Height: 16,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameEpochStorageFundBalanceChart,
+			},
+		},
+		5: {
+			Height: 16,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameEpochReferenceGasPriceChart,
+			},
+		},
+	}
+
+	CellsConfigEpochsHistory = CellsConfig{
+		enums.ColumnNameEpoch:                                  {"LAST EPOCH", cell.ColorGreen},
+		enums.ColumnNameEpochTotalTransactions:                 {"LAST EPOCH TOTAL TRANSACTIONS", cell.ColorGreen},
+		enums.ColumnNameEpochReferenceGasPrice:                 {"LAST EPOCH REFERENCE GAS PRICE", cell.ColorGreen},
+		enums.ColumnNameEpochTotalTransactionsChart:            {"TOTAL TRANSACTIONS PER EPOCH", cell.ColorBlue},
+		enums.ColumnNameEpochTotalGasFeesChart:                 {"TOTAL GAS FEES PER EPOCH, SUI", cell.ColorYellow},
+		enums.ColumnNameEpochTotalStakeRewardsDistributedChart: {"STAKE REWARDS DISTRIBUTED PER EPOCH, SUI", cell.ColorGreen},
+		enums.ColumnNameEpochStorageFundBalanceChart:           {"STORAGE FUND BALANCE, SUI", cell.ColorBlue},
+		enums.ColumnNameEpochReferenceGasPriceChart:            {"REFERENCE GAS PRICE", cell.ColorYellow},
+	}
+)
+
+// SparkLineSeries holds the values of the sparkline chart, which replace the values rendered previously, and the label of the chart.
+type SparkLineSeries struct {
+	Values []int
+	Label  string
+}
+
+// GetEpochsHistoryColumnValues returns the values of the last ended epoch and the charts of the ended epochs on the specified host.
+// The epochs are charted from the oldest to the newest one, the epochs without the end of epoch info are skipped.
+func GetEpochsHistoryColumnValues(host host.Host) (ColumnValues, error) {
+	result := ColumnValues{
+		enums.ColumnNameEpoch:                  "",
+		enums.ColumnNameEpochTotalTransactions: "",
+		enums.ColumnNameEpochReferenceGasPrice: "",
+	}
+
+	charts := []struct {
+		columnName enums.ColumnName
+		value      func(epoch *domainmetrics.EpochInfo) (int64, error)
+	}{
+		{enums.ColumnNameEpochTotalTransactionsChart, func(epoch *domainmetrics.EpochInfo) (int64, error) {
+			return parseEpochValue(epoch.EpochTotalTransactions)
+		}},
+		{enums.ColumnNameEpochTotalGasFeesChart, func(epoch *domainmetrics.EpochInfo) (int64, error) {
+			return domainmetrics.MistToSui(epoch.EndOfEpochInfo.TotalGasFees)
+		}},
+		{enums.ColumnNameEpochTotalStakeRewardsDistributedChart, func(epoch *domainmetrics.EpochInfo) (int64, error) {
+			return domainmetrics.MistToSui(epoch.EndOfEpochInfo.TotalStakeRewardsDistributed)
+		}},
+		{enums.ColumnNameEpochStorageFundBalanceChart, func(epoch *domainmetrics.EpochInfo) (int64, error) {
+			return domainmetrics.MistToSui(epoch.EndOfEpochInfo.StorageFundBalance)
+		}},
+		{enums.ColumnNameEpochReferenceGasPriceChart, func(epoch *domainmetrics.EpochInfo) (int64, error) {
+			return parseEpochValue(epoch.EndOfEpochInfo.ReferenceGasPrice)
+		}},
+	}
+
+	epochsHistory := host.Metrics.EpochsHistory
+	values := make(map[enums.ColumnName][]int, len(charts))
+
+	for idx := len(epochsHistory) - 1; idx >= 0; idx-- {
+		epoch := &epochsHistory[idx]
+		if epoch.EndOfEpochInfo == nil {
+			continue
+		}
+
+		for _, chart := range charts {
+			value, err := chart.value(epoch)
+			if err != nil {
+				return nil, err
+			}
+
+			values[chart.columnName] = append(values[chart.columnName], int(value))
+		}
+
+		result[enums.ColumnNameEpoch] = epoch.Epoch
+		result[enums.ColumnNameEpochTotalTransactions] = epoch.EpochTotalTransactions
+		result[enums.ColumnNameEpochReferenceGasPrice] = epoch.EndOfEpochInfo.ReferenceGasPrice
+	}
+
+	for _, chart := range charts {
+		result[chart.columnName] = newSparkLineSeries(values[chart.columnName])
+	}
+
+	return result, nil
+}
+
+// newSparkLineSeries creates the series of the chart labeled with the number of the charted epochs and the range and the last of the values.
+func newSparkLineSeries(values []int) SparkLineSeries {
+	if len(values) == 0 {
+		return SparkLineSeries{}
+	}
+
+	minValue, maxValue := values[0], values[0]
+
+	for _, value := range values {
+		if value < minValue {
+			minValue = value
+		}
+
+		if value > maxValue {
+			maxValue = value
+		}
+	}
+
+	return SparkLineSeries{
+		Values: values,
+		Label:  fmt.Sprintf("LAST %d EPOCHS, MIN: %d, MAX: %d, LAST: %d", len(values), minValue, maxValue, values[len(values)-1]),
+	}
+}
+
+// parseEpochValue parses the integer value of the epoch info.
+func parseEpochValue(value string) (int64, error) {
+	intValue, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected epoch value: %s", value)
+	}
+
+	return intValue, nil
+}
@@ -0,0 +1,177 @@
+package dashboards
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/container"
+	"github.com/mum4k/termdash/container/grid"
+	"github.com/mum4k/termdash/widgets/sparkline"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+)
+
+const (
+	// fleetGridSize is the percentage of the dashboard height and width shared by the tiles of the fleet dashboard.
+	fleetGridSize = 99
+
+	// fleetSummaryHeight is the percentage of the tile height taken by the summary of the host, the rest is taken by the chart.
+	fleetSummaryHeight = 60
+
+	fleetSummaryLineFormat = "%-16s"
+)
+
+// FleetTile holds the cells of the tile rendering a single host on the fleet dashboard:
+// the summary of the host health and the transactions per second chart below it.
+type FleetTile struct {
+	Title   string
+	Color   cell.Color
+	Summary *Cell
+	Chart   *Cell
+}
+
+// NewFleetTile creates the tile of the host titled with the host type and address.
+// The full nodes tiles are framed in green and the validators tiles in blue.
+// It returns the new tile and an error, if any.
+func NewFleetTile(host host.Host) (*FleetTile, error) {
+	summaryWidget, err := newWidgetOfType(enums.WidgetTypeTextNoScroll, cell.ColorWhite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize text widget for %s: %w", host.Endpoint.Address, err)
+	}
+
+	color := cell.ColorGreen
+	if host.TableType == enums.TableTypeValidator {
+		color = cell.ColorBlue
+	}
+
+	chartWidget, err := newWidgetOfType(enums.WidgetTypeSparkLine, color)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize sparkline widget for %s: %w", host.Endpoint.Address, err)
+	}
+
+	if err = chartWidget.(*sparkline.SparkLine).Add([]int{0}); err != nil {
+		return nil, fmt.Errorf("failed to set initial value for %s: %w", host.Endpoint.Address, err)
+	}
+
+	return &FleetTile{
+		Title:   fleetTileTitle(host),
+		Color:   color,
+		Summary: &Cell{Widget: summaryWidget, LastUpdatedAt: time.Now()},
+		Chart:   &Cell{Widget: chartWidget, LastUpdatedAt: time.Now()},
+	}, nil
+}
+
+// GetWidget returns the tile as a column of the specified width percentage, which can be added to a dashboard grid row.
+func (t *FleetTile) GetWidget(width int) grid.Element {
+	options := append(CellConfigDefault, container.BorderTitle(t.Title), container.BorderColor(t.Color))
+
+	return grid.ColWidthPercWithOpts(width, options,
+		grid.RowHeightPerc(fleetSummaryHeight, t.Summary.GetWidget()),
+		grid.RowHeightPerc(fleetGridSize-fleetSummaryHeight, t.Chart.GetWidget()),
+	)
+}
+
+// Write writes the health and the metrics of the host to the tile. The checkpoint lag is calculated
+// against the latest checkpoint of the reference RPC host and is not available until both of them respond.
+func (t *FleetTile) Write(host host.Host, reference host.Host) error {
+	metrics := host.Metrics
+
+	checkpointLag := "N/A"
+	if metrics.Updated && metrics.HighestSyncedCheckpoint > 0 && reference.Metrics.LatestCheckpoint > 0 {
+		lag := reference.Metrics.LatestCheckpoint - metrics.HighestSyncedCheckpoint
+		if lag < 0 {
+			lag = 0
+		}
+
+		checkpointLag = fmt.Sprint(lag)
+	}
+
+	status := host.Status
+	if status == "" {
+		status = enums.StatusGrey
+	}
+
+	summary := []TextChunk{
+		{Text: fmt.Sprintf(fleetSummaryLineFormat, "STATUS")},
+		{Text: strings.ToUpper(status.ToLabel()) + "\n", Color: statusColor(status)},
+		{Text: fmt.Sprintf(fleetSummaryLineFormat+"%s\n", "CHECKPOINT LAG", checkpointLag)},
+		{Text: fmt.Sprintf(fleetSummaryLineFormat+"%d\n", "NETWORK PEERS", metrics.NetworkPeers)},
+		{Text: fmt.Sprintf(fleetSummaryLineFormat+"%s\n", "VERSION", metrics.Version)},
+		{Text: fmt.Sprintf(fleetSummaryLineFormat+"%d\n", "TPS", metrics.TransactionsPerSecond)},
+	}
+
+	if len(host.FailedChecks) > 0 {
+		summary = append(summary, TextChunk{Text: host.FailedChecks.String(), Color: statusColor(status)})
+	}
+
+	if err := t.Summary.Write(summary); err != nil {
+		return err
+	}
+
+	return t.Chart.Write(metrics.TransactionsPerSecond)
+}
+
+// GetFleetRows lays the tiles out in a grid which auto-sizes to the number of the tiles: the number of columns
+// is the square root of the tiles count rounded up and the rows share the dashboard height equally.
+func GetFleetRows(tiles []*FleetTile) Rows {
+	if len(tiles) == 0 {
+		return nil
+	}
+
+	columnsCount := int(math.Ceil(math.Sqrt(float64(len(tiles)))))
+	rowsCount := (len(tiles) + columnsCount - 1) / columnsCount
+
+	rows := make(Rows, 0, rowsCount+1)
+
+	for start := 0; start < len(tiles); start += columnsCount {
+		end := start + columnsCount
+		if end > len(tiles) {
+			end = len(tiles)
+		}
+
+		columns := make([]grid.Element, 0, end-start)
+
+		for _, tile := range tiles[start:end] {
+			columns = append(columns, tile.GetWidget(fleetGridSize/columnsCount))
+		}
+
+		// pad the last row with empty columns, so its tiles are as wide as the ones above
+		for len(columns) < columnsCount {
+			columns = append(columns, NewColumnPct(fleetGridSize/columnsCount))
+		}
+
+		rows = append(rows, NewRowPct(fleetGridSize/rowsCount, columns...))
+	}
+
+	// add empty row to limit last row height
+	rows = append(rows, NewRowPct(emptyRowHeight))
+
+	return rows
+}
+
+// fleetTileTitle returns the title of the host tile: the host type and the address of the host.
+func fleetTileTitle(host host.Host) string {
+	if host.TableType == enums.TableTypeValidator {
+		return "🤖 " + host.HostAddress()
+	}
+
+	return "💻 " + host.HostAddress()
+}
+
+// statusColor returns the color the health status is rendered in.
+func statusColor(status enums.Status) cell.Color {
+	switch status {
+	case enums.StatusGreen:
+		return cell.ColorGreen
+	case enums.StatusYellow:
+		return cell.ColorYellow
+	case enums.StatusRed:
+		return cell.ColorRed
+	default:
+		return cell.ColorGray
+	}
+}
@@ -0,0 +1,296 @@
+package dashboards
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mum4k/termdash/cell"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	domainhost "github.com/bartosian/suimon/internal/core/domain/host"
+)
+
+const (
+	// maxRowsHeight is the sum of the rows heights, the empty row limiting the last row height takes the rest.
+	maxRowsHeight = 100 - emptyRowHeight
+	// maxCellsWidth is the sum of the cells widths within a row.
+	maxCellsWidth = 100
+)
+
+// layoutColors holds the names of the cell colors used in the dashboards layouts of the configuration.
+var layoutColors = []struct {
+	name  string
+	color cell.Color
+}{
+	{"black", cell.ColorBlack},
+	{"red", cell.ColorRed},
+	{"green", cell.ColorGreen},
+	{"yellow", cell.ColorYellow},
+	{"blue", cell.ColorBlue},
+	{"magenta", cell.ColorMagenta},
+	{"cyan", cell.ColorCyan},
+	{"white", cell.ColorWhite},
+	{"gray", cell.ColorGray},
+}
+
+type (
+	// WidgetsConfig is a type that maps column names to the types of the widgets rendering them.
+	// The columns missing in the map are rendered with their default widgets.
+	WidgetsConfig map[enums.ColumnName]enums.WidgetType
+
+	// Layout holds the configurations the cells of the dashboard are created and laid out with.
+	Layout struct {
+		Dashboard enums.TableType
+		Columns   ColumnsConfig
+		Rows      RowsConfig
+		Cells     CellsConfig
+		Widgets   WidgetsConfig
+	}
+
+	// LayoutError is the problem found in the dashboard layout of the configuration.
+	// Path points to the entry of the layout the problem is found in, e.g. rows[0].cells[1].
+	LayoutError struct {
+		Path    string
+		Message string
+	}
+)
+
+// Error returns the problem prefixed with the path of the entry it is found in.
+func (err *LayoutError) Error() string {
+	if err.Path == "" {
+		return err.Message
+	}
+
+	return fmt.Sprintf("%s: %s", err.Path, err.Message)
+}
+
+// WidgetType returns the type of the widget rendering the column.
+func (widgets WidgetsConfig) WidgetType(columnName enums.ColumnName) enums.WidgetType {
+	if widgetType, ok := widgets[columnName]; ok {
+		return widgetType
+	}
+
+	return DefaultWidgetType(columnName)
+}
+
+// DefaultLayout returns the built-in layout of the specified dashboard type.
+func DefaultLayout(dashboard enums.TableType) (Layout, error) {
+	columnsConfig, err := GetColumnsConfig(dashboard)
+	if err != nil {
+		return Layout{}, err
+	}
+
+	rowsConfig, err := GetRowsConfig(dashboard)
+	if err != nil {
+		return Layout{}, err
+	}
+
+	cellsConfig, err := GetCellsConfig(dashboard)
+	if err != nil {
+		return Layout{}, err
+	}
+
+	return Layout{
+		Dashboard: dashboard,
+		Columns:   columnsConfig,
+		Rows:      rowsConfig,
+		Cells:     cellsConfig,
+	}, nil
+}
+
+// GetLayout returns the layout of the specified dashboard type rendering the host from the layouts of the configuration,
+// the built-in layout is returned if the dashboard type is not laid out in the configuration. The built-in layout
+// of the validator dashboard gets the row of the on-chain state only if the validator is tracked by its Sui address or name.
+func GetLayout(dashboard enums.TableType, host domainhost.Host, layouts map[enums.TableType]Layout) (Layout, error) {
+	if layout, ok := layouts[dashboard]; ok {
+		return layout, nil
+	}
+
+	layout, err := DefaultLayout(dashboard)
+	if err != nil {
+		return Layout{}, err
+	}
+
+	if dashboard == enums.TableTypeValidator && host.Tracked() {
+		layout.Rows = RowsConfigTrackedValidator
+	}
+
+	return layout, nil
+}
+
+// NewLayout parses the layout of the dashboard named by the alias, e.g. node, from the configuration.
+// The columns are named by their aliases and have to be rendered by the built-in layout of the dashboard,
+// each of them at most once. The widget type, the title and the color of the built-in cell are used
+// unless they are provided. The heights of the rows and the widths of the cells within a row are percentages,
+// their sums must not exceed 99 and 100 accordingly. It returns a *LayoutError if the layout is invalid.
+func NewLayout(alias string, layoutConfig config.DashboardLayout) (Layout, error) {
+	dashboard, ok := enums.TableTypeFromAlias(alias)
+	if !ok {
+		return Layout{}, &LayoutError{Message: fmt.Sprintf("unsupported dashboard %q", alias)}
+	}
+
+	if dashboard == enums.TableTypeFleet {
+		return Layout{}, &LayoutError{Message: "the fleet dashboard can not be laid out, its tiles are laid out by the number of hosts"}
+	}
+
+	defaultLayout, err := DefaultLayout(dashboard)
+	if err != nil {
+		return Layout{}, &LayoutError{Message: fmt.Sprintf("the %s dashboard can not be laid out: %s", alias, err)}
+	}
+
+	if len(layoutConfig.Rows) == 0 {
+		return Layout{}, &LayoutError{Path: "rows", Message: "at least one row has to be provided"}
+	}
+
+	columnsByAlias := make(map[string]enums.ColumnName, len(defaultLayout.Cells))
+	for columnName := range defaultLayout.Cells {
+		columnsByAlias[columnName.Alias()] = columnName
+	}
+
+	layout := Layout{
+		Dashboard: dashboard,
+		Columns:   make(ColumnsConfig),
+		Rows:      make(RowsConfig, 0, len(layoutConfig.Rows)),
+		Cells:     make(CellsConfig),
+		Widgets:   make(WidgetsConfig),
+	}
+
+	var rowsHeight int
+
+	for rowIdx, layoutRow := range layoutConfig.Rows {
+		rowPath := fmt.Sprintf("rows[%d]", rowIdx)
+
+		if layoutRow.Height <= 0 || layoutRow.Height > maxRowsHeight {
+			return Layout{}, &LayoutError{Path: rowPath, Message: fmt.Sprintf("invalid height %d, it has to be between 1 and %d", layoutRow.Height, maxRowsHeight)}
+		}
+
+		if rowsHeight += layoutRow.Height; rowsHeight > maxRowsHeight {
+			return Layout{}, &LayoutError{Path: rowPath, Message: fmt.Sprintf("the rows heights sum up to %d, it must not exceed %d", rowsHeight, maxRowsHeight)}
+		}
+
+		if len(layoutRow.Cells) == 0 {
+			return Layout{}, &LayoutError{Path: rowPath, Message: "at least one cell has to be provided"}
+		}
+
+		row := RowConfig{
+			Height:  layoutRow.Height,
+			Columns: make([]enums.ColumnName, 0, len(layoutRow.Cells)),
+		}
+
+		var cellsWidth int
+
+		for cellIdx, layoutCell := range layoutRow.Cells {
+			cellPath := fmt.Sprintf("%s.cells[%d]", rowPath, cellIdx)
+
+			columnAlias := strings.ToLower(strings.TrimSpace(layoutCell.Column))
+
+			columnName, ok := columnsByAlias[columnAlias]
+			if !ok {
+				return Layout{}, &LayoutError{Path: cellPath, Message: fmt.Sprintf("unsupported column %q, the %s dashboard renders: %s", layoutCell.Column, alias, columnAliases(columnsByAlias))}
+			}
+
+			if _, ok := layout.Columns[columnName]; ok {
+				return Layout{}, &LayoutError{Path: cellPath, Message: fmt.Sprintf("column %q is rendered more than once", columnAlias)}
+			}
+
+			if layoutCell.Width <= 0 || layoutCell.Width >= maxCellsWidth {
+				return Layout{}, &LayoutError{Path: cellPath, Message: fmt.Sprintf("invalid width %d, it has to be between 1 and %d", layoutCell.Width, maxCellsWidth-1)}
+			}
+
+			if cellsWidth += layoutCell.Width; cellsWidth > maxCellsWidth {
+				return Layout{}, &LayoutError{Path: cellPath, Message: fmt.Sprintf("the cells widths of the row sum up to %d, it must not exceed %d", cellsWidth, maxCellsWidth)}
+			}
+
+			cellConfig := defaultLayout.Cells[columnName]
+
+			if title := strings.TrimSpace(layoutCell.Title); title != "" {
+				cellConfig.Title = title
+			}
+
+			if colorName := strings.ToLower(strings.TrimSpace(layoutCell.Color)); colorName != "" {
+				color, ok := layoutColor(colorName)
+				if !ok {
+					return Layout{}, &LayoutError{Path: cellPath, Message: fmt.Sprintf("unsupported color %q, the supported colors are: %s", layoutCell.Color, layoutColorNames())}
+				}
+
+				cellConfig.Color = color
+			}
+
+			widgetType := DefaultWidgetType(columnName)
+
+			if strings.TrimSpace(layoutCell.Widget) != "" {
+				if widgetType, ok = enums.WidgetTypeFromAlias(layoutCell.Widget); !ok || !isColumnWidgetType(columnName, widgetType) {
+					return Layout{}, &LayoutError{Path: cellPath, Message: fmt.Sprintf("unsupported widget %q, column %q is rendered with: %s", layoutCell.Widget, columnAlias, columnWidgetAliases(columnName))}
+				}
+			}
+
+			layout.Columns[columnName] = layoutCell.Width
+			layout.Cells[columnName] = cellConfig
+			layout.Widgets[columnName] = widgetType
+
+			row.Columns = append(row.Columns, columnName)
+		}
+
+		layout.Rows = append(layout.Rows, row)
+	}
+
+	return layout, nil
+}
+
+// isColumnWidgetType checks whether the column can be rendered with the widget type.
+func isColumnWidgetType(columnName enums.ColumnName, widgetType enums.WidgetType) bool {
+	for _, columnWidgetType := range ColumnWidgetTypes(columnName) {
+		if columnWidgetType == widgetType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// columnWidgetAliases returns the comma-separated names of the widget types the column can be rendered with.
+func columnWidgetAliases(columnName enums.ColumnName) string {
+	widgetTypes := ColumnWidgetTypes(columnName)
+
+	aliases := make([]string, 0, len(widgetTypes))
+	for _, widgetType := range widgetTypes {
+		aliases = append(aliases, widgetType.Alias())
+	}
+
+	return strings.Join(aliases, ", ")
+}
+
+// columnAliases returns the comma-separated aliases of the columns in ascending order.
+func columnAliases(columnsByAlias map[string]enums.ColumnName) string {
+	aliases := make([]string, 0, len(columnsByAlias))
+	for alias := range columnsByAlias {
+		aliases = append(aliases, alias)
+	}
+
+	sort.Strings(aliases)
+
+	return strings.Join(aliases, ", ")
+}
+
+// layoutColor resolves the cell color by its name, e.g. green, and returns false if the name is unknown.
+func layoutColor(name string) (cell.Color, bool) {
+	for _, entry := range layoutColors {
+		if entry.name == name {
+			return entry.color, true
+		}
+	}
+
+	return 0, false
+}
+
+// layoutColorNames returns the comma-separated names of the cell colors.
+func layoutColorNames() string {
+	names := make([]string, 0, len(layoutColors))
+	for _, entry := range layoutColors {
+		names = append(names, entry.name)
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -12,13 +12,14 @@ import (
 var (
 	ColumnsConfigNode = ColumnsConfig{
 		// Overview section
-		enums.ColumnNameCurrentEpoch:          33,
+		enums.ColumnNameCurrentEpoch:          24,
 		enums.ColumnNameNetworkPeers:          15,
 		enums.ColumnNameUptime:                25,
 		enums.ColumnNameVersion:               25,
 		enums.ColumnNameCommit:                25,
-		enums.ColumnNameCheckpointExecBacklog: 33,
-		enums.ColumnNameCheckpointSyncBacklog: 33,
+		enums.ColumnNameCheckpointExecBacklog: 24,
+		enums.ColumnNameCheckpointSyncBacklog: 24,
+		enums.ColumnNameReason:                27,
 
 		// Transactions section
 		enums.ColumnNameTotalTransactionBlocks:       33,
@@ -52,6 +53,7 @@ var (
 				enums.ColumnNameCurrentEpoch,
 				enums.ColumnNameCheckpointExecBacklog,
 				enums.ColumnNameCheckpointSyncBacklog,
+				enums.ColumnNameReason,
 			},
 		},
 		2: {
@@ -95,6 +97,7 @@ var (
 		enums.ColumnNameCurrentEpoch:                 {"CURRENT EPOCH", cell.ColorGreen},
 		enums.ColumnNameCheckpointExecBacklog:        {"CHECKPOINT EXEC BACKLOG", cell.ColorGreen},
 		enums.ColumnNameCheckpointSyncBacklog:        {"CHECKPOINT SYNC BACKLOG", cell.ColorGreen},
+		enums.ColumnNameReason:                       {"REASON", cell.ColorRed},
 		enums.ColumnNameHighestKnownCheckpoint:       {"HIGHEST KNOWN CHECKPOINT", cell.ColorBlue},
 		enums.ColumnNameHighestSyncedCheckpoint:      {"HIGHEST SYNCED CHECKPOINT", cell.ColorBlue},
 		enums.ColumnNameLastExecutedCheckpoint:       {"LAST EXECUTED CHECKPOINT", cell.ColorBlue},
@@ -132,5 +135,6 @@ func GetNodeColumnValues(host host.Host) ColumnValues {
 		enums.ColumnNameUptime:                       host.Metrics.Uptime,
 		enums.ColumnNameVersion:                      host.Metrics.Version,
 		enums.ColumnNameCommit:                       host.Metrics.Commit,
+		enums.ColumnNameReason:                       host.FailedChecks.String(),
 	}
 }
@@ -10,10 +10,11 @@ import (
 var (
 	ColumnsConfigRPC = ColumnsConfig{
 		// Overview section
-		enums.ColumnNameCurrentEpoch:            19,
-		enums.ColumnNameSystemTimeTillNextEpoch: 19,
-		enums.ColumnNameTotalTransactionBlocks:  30,
-		enums.ColumnNameLatestCheckpoint:        30,
+		enums.ColumnNameCurrentEpoch:            16,
+		enums.ColumnNameSystemTimeTillNextEpoch: 16,
+		enums.ColumnNameTotalTransactionBlocks:  24,
+		enums.ColumnNameLatestCheckpoint:        24,
+		enums.ColumnNameReason:                  19,
 	}
 
 	RowsConfigRPC = RowsConfig{
@@ -24,6 +25,7 @@ var (
 				enums.ColumnNameSystemTimeTillNextEpoch,
 				enums.ColumnNameTotalTransactionBlocks,
 				enums.ColumnNameLatestCheckpoint,
+				enums.ColumnNameReason,
 			},
 		},
 	}
@@ -33,6 +35,7 @@ var (
 		enums.ColumnNameSystemTimeTillNextEpoch: {"TIME TILL NEXT EPOCH", cell.ColorGreen},
 		enums.ColumnNameTotalTransactionBlocks:  {"TOTAL TRANSACTION BLOCKS", cell.ColorYellow},
 		enums.ColumnNameLatestCheckpoint:        {"LATEST CHECKPOINT", cell.ColorBlue},
+		enums.ColumnNameReason:                  {"REASON", cell.ColorRed},
 	}
 )
 
@@ -45,5 +48,6 @@ func GetRPCColumnValues(host host.Host) ColumnValues {
 		enums.ColumnNameLatestCheckpoint:        host.Metrics.LatestCheckpoint,
 		enums.ColumnNameCurrentEpoch:            host.Metrics.SystemState.Epoch,
 		enums.ColumnNameSystemTimeTillNextEpoch: host.Metrics.DurationTillEpochEndHHMM,
+		enums.ColumnNameReason:                  host.FailedChecks.String(),
 	}
 }
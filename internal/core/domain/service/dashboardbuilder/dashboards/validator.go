@@ -13,9 +13,10 @@ var (
 	ColumnsConfigValidator = ColumnsConfig{
 		// Overview section
 		enums.ColumnNameCurrentEpoch: 20,
-		enums.ColumnNameUptime:       25,
-		enums.ColumnNameVersion:      25,
-		enums.ColumnNameCommit:       25,
+		enums.ColumnNameUptime:       20,
+		enums.ColumnNameVersion:      20,
+		enums.ColumnNameCommit:       20,
+		enums.ColumnNameReason:       19,
 
 		// Transactions section
 		enums.ColumnNameTotalTransactionCertificates: 33,
@@ -44,6 +45,16 @@ var (
 		// Performance section
 		enums.ColumnNameSkippedConsensusTransactions: 19,
 		enums.ColumnNameTotalSignatureErrors:         19,
+
+		// On-chain section
+		enums.ColumnNameValidatorName:                       12,
+		enums.ColumnNameValidatorVotingPower:                12,
+		enums.ColumnNameValidatorNextEpochStake:             12,
+		enums.ColumnNameValidatorCommissionRate:             12,
+		enums.ColumnNameValidatorNextEpochGasPrice:          12,
+		enums.ColumnNameValidatorApy:                        12,
+		enums.ColumnNameSystemAtRiskValidatorNumberOfEpochs: 12,
+		enums.ColumnNameSystemValidatorSlashingPercentage:   15,
 	}
 
 	RowsConfigValidator = RowsConfig{
@@ -54,6 +65,7 @@ var (
 				enums.ColumnNameUptime,
 				enums.ColumnNameVersion,
 				enums.ColumnNameCommit,
+				enums.ColumnNameReason,
 			},
 		},
 		1: {
@@ -106,11 +118,88 @@ var (
 		},
 	}
 
+	// RowsConfigTrackedValidator lays the validator dashboard out with the row of the on-chain state, it is used for the validators
+	// tracked by their Sui address or name.
+	RowsConfigTrackedValidator = RowsConfig{
+		0: {
+			Height: 12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameCurrentEpoch,
+				enums.ColumnNameUptime,
+				enums.ColumnNameVersion,
+				enums.ColumnNameCommit,
+				enums.ColumnNameReason,
+			},
+		},
+		1: {
+			Height: 12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameNetworkPeers,
+				enums.ColumnNamePrimaryNetworkPeers,
+				enums.ColumnNameWorkerNetworkPeers,
+				enums.ColumnNameSkippedConsensusTransactions,
+				enums.ColumnNameTotalSignatureErrors,
+			},
+		},
+		2: {
+			Height: 12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameLastExecutedCheckpoint,
+				enums.ColumnNameHighestKnownCheckpoint,
+				enums.ColumnNameHighestSyncedCheckpoint,
+			},
+		},
+		3: {
+			Height: 12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameCheckSyncPercentage,
+				enums.ColumnNameCheckpointsPerSecond,
+			},
+		},
+		4: {
+			Height: 12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameTotalTransactionCertificates,
+				enums.ColumnNameTotalTransactionEffects,
+				enums.ColumnNameCertificatesCreated,
+			},
+		},
+		5: {
+			Height: 12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameCurrentRound,
+				enums.ColumnNameHighestProcessedRound,
+				enums.ColumnNameLastCommittedRound,
+			},
+		},
+		6: {
+			Height: 12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameCertificatesPerSecond,
+				enums.ColumnNameRoundsPerSecond,
+			},
+		},
+		7: {
+			Height: 12,
+			Columns: []enums.ColumnName{
+				enums.ColumnNameValidatorName,
+				enums.ColumnNameValidatorVotingPower,
+				enums.ColumnNameValidatorNextEpochStake,
+				enums.ColumnNameValidatorCommissionRate,
+				enums.ColumnNameValidatorNextEpochGasPrice,
+				enums.ColumnNameValidatorApy,
+				enums.ColumnNameSystemAtRiskValidatorNumberOfEpochs,
+				enums.ColumnNameSystemValidatorSlashingPercentage,
+			},
+		},
+	}
+
 	CellsConfigValidator = CellsConfig{
 		enums.ColumnNameCurrentEpoch:                            {"CURRENT EPOCH", cell.ColorGreen},
 		enums.ColumnNameUptime:                                  {"UPTIME", cell.ColorGreen},
 		enums.ColumnNameVersion:                                 {"VERSION", cell.ColorGreen},
 		enums.ColumnNameCommit:                                  {"COMMIT", cell.ColorGreen},
+		enums.ColumnNameReason:                                  {"REASON", cell.ColorRed},
 		enums.ColumnNameNetworkPeers:                            {"SUI NETWORK PEERS", cell.ColorGreen},
 		enums.ColumnNamePrimaryNetworkPeers:                     {"PRIMARY NETWORK PEERS", cell.ColorGreen},
 		enums.ColumnNameWorkerNetworkPeers:                      {"WORKER NETWORK PEERS", cell.ColorGreen},
@@ -132,6 +221,14 @@ var (
 		enums.ColumnNameRoundsPerSecond:                         {"ROUNDS RATIO", cell.ColorRed},
 		enums.ColumnNameCertificatesPerSecond:                   {"CERTIFICATES RATIO", cell.ColorYellow},
 		enums.ColumnNameHandleCertificateNonConsensusLatencySum: {"CERTIFICATE NON CONSENSUS LATENCY", cell.ColorRed},
+		enums.ColumnNameValidatorName:                           {"VALIDATOR NAME", cell.ColorGreen},
+		enums.ColumnNameValidatorVotingPower:                    {"VOTING POWER", cell.ColorGreen},
+		enums.ColumnNameValidatorNextEpochStake:                 {"NEXT EPOCH STAKE, SUI", cell.ColorGreen},
+		enums.ColumnNameValidatorCommissionRate:                 {"COMMISSION RATE", cell.ColorGreen},
+		enums.ColumnNameValidatorNextEpochGasPrice:              {"NEXT EPOCH GAS PRICE", cell.ColorGreen},
+		enums.ColumnNameValidatorApy:                            {"APY, %", cell.ColorGreen},
+		enums.ColumnNameSystemAtRiskValidatorNumberOfEpochs:     {"EPOCHS AT RISK", cell.ColorGreen},
+		enums.ColumnNameSystemValidatorSlashingPercentage:       {"SLASHING PCT", cell.ColorGreen},
 	}
 )
 
@@ -154,6 +251,7 @@ func GetValidatorColumnValues(host host.Host) ColumnValues {
 		enums.ColumnNameUptime:                                  host.Metrics.Uptime,
 		enums.ColumnNameVersion:                                 host.Metrics.Version,
 		enums.ColumnNameCommit:                                  host.Metrics.Commit,
+		enums.ColumnNameReason:                                  host.FailedChecks.String(),
 		enums.ColumnNameCurrentRound:                            host.Metrics.CurrentRound,
 		enums.ColumnNameHighestProcessedRound:                   host.Metrics.HighestProcessedRound,
 		enums.ColumnNameLastCommittedRound:                      host.Metrics.LastCommittedRound,
@@ -167,5 +265,14 @@ func GetValidatorColumnValues(host host.Host) ColumnValues {
 		enums.ColumnNameHandleCertificateNonConsensusLatencySum: host.Metrics.NonConsensusLatency,
 	}
 
+	// the cells of the validator not found in the system state are left empty, so they show the loading placeholder
+	for columnName, value := range host.OnChain.ColumnValues() {
+		if value == nil {
+			value = ""
+		}
+
+		columnValues[columnName] = value
+	}
+
 	return columnValues
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/linestyle"
 	"github.com/mum4k/termdash/widgetapi"
+	"github.com/mum4k/termdash/widgets/barchart"
 	"github.com/mum4k/termdash/widgets/gauge"
 	"github.com/mum4k/termdash/widgets/segmentdisplay"
 	"github.com/mum4k/termdash/widgets/sparkline"
@@ -27,71 +28,104 @@ func newWidgetOfType(widgetType enums.WidgetType, color cell.Color) (widgetapi.W
 		return newDisplayWidget()
 	case enums.WidgetTypeSparkLine:
 		return newSparklineWidget(color)
+	case enums.WidgetTypeBarChart:
+		return newBarChartWidget()
+	case enums.WidgetTypeText:
+		return newTextWidget()
 	default:
 		return nil, fmt.Errorf("invalid widget type: %d", widgetType)
 	}
 }
 
-// newWidgetByColumnName initializes a new widget based on the given column name.
-// It returns the new widget and an error, if any.
-func newWidgetByColumnName(columnName enums.ColumnName, color cell.Color) (widgetapi.Widget, error) {
+// DefaultWidgetType returns the type of the widget the column is rendered with in the built-in layouts.
+func DefaultWidgetType(columnName enums.ColumnName) enums.WidgetType {
 	switch columnName {
 	case enums.ColumnNameTXSyncPercentage, enums.ColumnNameCheckSyncPercentage:
-		widget, err := newWidgetOfType(enums.WidgetTypeProgress, color)
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize gauge widget for %s: %w", columnName, err)
-		}
-
-		progressWidget := widget.(*gauge.Gauge)
+		return enums.WidgetTypeProgress
+	case enums.ColumnNameHealth, enums.ColumnNameReason:
+		return enums.WidgetTypeTextNoScroll
+	case enums.ColumnNameEpochTotalTransactionsChart, enums.ColumnNameEpochTotalGasFeesChart, enums.ColumnNameEpochTotalStakeRewardsDistributedChart,
+		enums.ColumnNameEpochStorageFundBalanceChart, enums.ColumnNameEpochReferenceGasPriceChart,
+		enums.ColumnNameCheckpointsPerSecond, enums.ColumnNameTransactionsPerSecond, enums.ColumnNameRoundsPerSecond, enums.ColumnNameCertificatesPerSecond:
+		return enums.WidgetTypeSparkLine
+	case enums.ColumnNameActiveValidatorsVotingPowerChart, enums.ColumnNameActiveValidatorsGasPriceSurvey:
+		return enums.WidgetTypeBarChart
+	case enums.ColumnNameActiveValidatorsRanking:
+		return enums.WidgetTypeText
+	default:
+		return enums.WidgetTypeDisplay
+	}
+}
 
-		if err = progressWidget.Percent(0); err != nil {
-			return nil, fmt.Errorf("failed to set initial value for %s: %w", columnName, err)
-		}
+// ColumnWidgetTypes returns the types of the widgets the column can be rendered with, the default one goes first.
+// The series of values are rendered with their default widgets only and the texts with the text widgets only,
+// the single values are also rendered with the segment display and the text widgets.
+func ColumnWidgetTypes(columnName enums.ColumnName) []enums.WidgetType {
+	defaultWidgetType := DefaultWidgetType(columnName)
 
-		return widget, nil
-	case enums.ColumnNameHealth:
-		widget, err := newWidgetOfType(enums.WidgetTypeTextNoScroll, color)
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize text widget for %s: %w", columnName, err)
-		}
+	var alternatives []enums.WidgetType
 
-		textWidget := widget.(*text.Text)
+	switch {
+	case defaultWidgetType == enums.WidgetTypeBarChart, isSeriesColumn(columnName):
+	case defaultWidgetType == enums.WidgetTypeText, defaultWidgetType == enums.WidgetTypeTextNoScroll:
+		alternatives = []enums.WidgetType{enums.WidgetTypeText, enums.WidgetTypeTextNoScroll}
+	default:
+		alternatives = []enums.WidgetType{enums.WidgetTypeDisplay, enums.WidgetTypeText, enums.WidgetTypeTextNoScroll}
+	}
 
-		if err = textWidget.Write(enums.StatusGrey.DashboardStatus(), text.WriteCellOpts(cell.FgColor(cell.ColorGray), cell.BgColor(cell.ColorGray))); err != nil {
-			return nil, fmt.Errorf("failed to set initial value for %s: %w", columnName, err)
-		}
+	widgetTypes := []enums.WidgetType{defaultWidgetType}
 
-		return widget, nil
-	case enums.ColumnNameCheckpointsPerSecond, enums.ColumnNameTransactionsPerSecond, enums.ColumnNameRoundsPerSecond, enums.ColumnNameCertificatesPerSecond:
-		widget, err := newWidgetOfType(enums.WidgetTypeSparkLine, color)
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize text widget for %s: %w", columnName, err)
+	for _, widgetType := range alternatives {
+		if widgetType != defaultWidgetType {
+			widgetTypes = append(widgetTypes, widgetType)
 		}
+	}
 
-		sparkLineWidget := widget.(*sparkline.SparkLine)
-
-		if err = sparkLineWidget.Add([]int{0}); err != nil {
-			return nil, fmt.Errorf("failed to set initial value for %s: %w", columnName, err)
-		}
+	return widgetTypes
+}
 
-		return widget, nil
+// isSeriesColumn checks whether the column value is the series of values replacing the values rendered previously.
+func isSeriesColumn(columnName enums.ColumnName) bool {
+	switch columnName {
+	case enums.ColumnNameEpochTotalTransactionsChart, enums.ColumnNameEpochTotalGasFeesChart, enums.ColumnNameEpochTotalStakeRewardsDistributedChart,
+		enums.ColumnNameEpochStorageFundBalanceChart, enums.ColumnNameEpochReferenceGasPriceChart,
+		enums.ColumnNameActiveValidatorsVotingPowerChart, enums.ColumnNameActiveValidatorsGasPriceSurvey:
+		return true
 	default:
-		widget, err := newWidgetOfType(enums.WidgetTypeDisplay, color)
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize segment display widget for %s: %w", columnName, err)
-		}
+		return false
+	}
+}
 
-		displayWidget := widget.(*segmentdisplay.SegmentDisplay)
+// newWidgetByColumnName initializes a new widget of the given type for the column and sets its initial value.
+// It returns the new widget and an error, if any.
+func newWidgetByColumnName(columnName enums.ColumnName, widgetType enums.WidgetType, color cell.Color) (widgetapi.Widget, error) {
+	widget, err := newWidgetOfType(widgetType, color)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize %s widget for %s: %w", widgetType.Alias(), columnName, err)
+	}
 
-		err = displayWidget.Write([]*segmentdisplay.TextChunk{
+	switch typedWidget := widget.(type) {
+	case *gauge.Gauge:
+		err = typedWidget.Percent(0)
+	case *text.Text:
+		if columnName == enums.ColumnNameHealth {
+			err = typedWidget.Write(enums.StatusGrey.DashboardStatus(), text.WriteCellOpts(cell.FgColor(cell.ColorGray), cell.BgColor(cell.ColorGray)))
+		}
+	case *sparkline.SparkLine:
+		if !isSeriesColumn(columnName) {
+			err = typedWidget.Add([]int{0})
+		}
+	case *segmentdisplay.SegmentDisplay:
+		err = typedWidget.Write([]*segmentdisplay.TextChunk{
 			segmentdisplay.NewChunk(dashboardLoadingBlinkValue(50), segmentdisplay.WriteCellOpts(cell.FgColor(cell.ColorWhite))),
 		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to set initial value for %s: %w", columnName, err)
-		}
+	}
 
-		return widget, nil
+	if err != nil {
+		return nil, fmt.Errorf("failed to set initial value for %s: %w", columnName, err)
 	}
+
+	return widget, nil
 }
 
 // newProgressWidget initializes a new progress widget with the given options.
@@ -115,6 +149,18 @@ func newTextNoScrollWidget() (*text.Text, error) {
 	return text.New(text.DisableScrolling(), text.WrapAtRunes())
 }
 
+// newTextWidget initializes a new text widget which is scrolled with the mouse wheel and the keyboard when focused.
+// It returns the new widget and an error, if any.
+func newTextWidget() (*text.Text, error) {
+	return text.New()
+}
+
+// newBarChartWidget initializes a new bar chart widget with the adjacent bars, so every value fits the chart.
+// It returns the new widget and an error, if any.
+func newBarChartWidget() (*barchart.BarChart, error) {
+	return barchart.New(barchart.BarGap(0))
+}
+
 // newSparklineWidget initializes a new sparkline widget with the given label and color.
 // It returns the new widget and an error, if any.
 func newSparklineWidget(color cell.Color) (*sparkline.SparkLine, error) {
@@ -0,0 +1,29 @@
+package dashboardbuilder
+
+// pollEpochs requests the current epoch from the host and refreshes the epochs history once a new epoch starts,
+// so the history of the last epochs is not requested on every poll. The dashboard fails over to the fallback hosts
+// if the host does not respond.
+func (state *pollState) pollEpochs() error {
+	current, err := state.host.GetEpoch(-1)
+	if err != nil {
+		if err := state.failover(err); err != nil {
+			return err
+		}
+
+		if current, err = state.host.GetEpoch(-1); err != nil {
+			return err
+		}
+	}
+
+	if current.Epoch == state.epoch {
+		return nil
+	}
+
+	if err := state.host.GetMetrics(); err != nil {
+		return err
+	}
+
+	state.epoch = current.Epoch
+
+	return nil
+}
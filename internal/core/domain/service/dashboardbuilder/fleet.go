@@ -0,0 +1,99 @@
+package dashboardbuilder
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+)
+
+// NewFleetBuilder creates a new Builder instance rendering a tile per host of the fleet.
+// The health of the hosts is checked against the reference RPC host on every update of their metrics.
+// If an error occurs during initialization, it returns an error.
+func NewFleetBuilder(
+	fleet []host.Host,
+	reference host.Host,
+	cliGateway *cligw.Gateway,
+) (*Builder, error) {
+	if len(fleet) == 0 {
+		return nil, errors.New("no full nodes or validators provided for the fleet dashboard")
+	}
+
+	builder, err := NewBuilder(enums.TableTypeFleet, fleet[0], reference, nil, nil, cliGateway)
+	if err != nil {
+		return nil, err
+	}
+
+	builder.fleet = fleet
+
+	return builder, nil
+}
+
+// initFleetRows creates the tiles of the fleet hosts and lays them out in the grid auto-sized to the number of the hosts.
+func (db *Builder) initFleetRows() (dashboards.Rows, error) {
+	tiles := make([]*dashboards.FleetTile, 0, len(db.fleet))
+
+	for _, fleetHost := range db.fleet {
+		tile, err := dashboards.NewFleetTile(fleetHost)
+		if err != nil {
+			return nil, err
+		}
+
+		tiles = append(tiles, tile)
+	}
+
+	db.tiles = tiles
+
+	return dashboards.GetFleetRows(tiles), nil
+}
+
+// writeTiles writes the latest values of the fleet hosts to their tiles.
+func (db *Builder) writeTiles() error {
+	for idx, tile := range db.tiles {
+		if err := tile.Write(db.fleet[idx], db.reference); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pollFleet requests the metrics of the reference RPC host and of all the fleet hosts concurrently and recalculates
+// the health of the hosts. The hosts which fail to respond are kept on the dashboard and marked as not updated,
+// so they are rendered as unhealthy, and the health is checked against the last metrics of the reference if it fails to respond.
+func (state *pollState) pollFleet() error {
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		_ = state.reference.GetMetrics()
+	}()
+
+	for idx := range state.fleet {
+		wg.Add(1)
+
+		go func(idx int) {
+			defer wg.Done()
+
+			if err := state.fleet[idx].GetMetrics(); err != nil {
+				state.fleet[idx].Metrics.Updated = false
+			}
+		}(idx)
+	}
+
+	wg.Wait()
+
+	for idx := range state.fleet {
+		if err := state.fleet[idx].SetHealth(state.reference); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package dashboardbuilder
+
+import (
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+// healthDashboards holds the dashboards showing the health of the host along with the failed checks.
+var healthDashboards = map[enums.TableType]bool{
+	enums.TableTypeNode:      true,
+	enums.TableTypeValidator: true,
+	enums.TableTypeRPC:       true,
+}
+
+// checkHealth calculates the sync progress and the health of the host against the reference RPC host, so the dashboard
+// explains the current status of the host. The reference is refreshed at most once per query interval and keeps its
+// last metrics if it fails to respond. The host serving as the reference is checked against itself.
+func (state *pollState) checkHealth() error {
+	if !healthDashboards[state.tableType] {
+		return nil
+	}
+
+	if state.isReference() {
+		return state.host.SetHealth(state.host)
+	}
+
+	if time.Since(state.referencedAt) >= queryInterval {
+		state.referencedAt = time.Now()
+
+		// The error is not reported, the health is checked against the last metrics of the reference instead.
+		_ = state.reference.GetMetrics()
+	}
+
+	return state.host.SetHealth(state.reference)
+}
+
+// isReference checks whether the host of the dashboard is the reference RPC host.
+func (state *pollState) isReference() bool {
+	hostURL, err := state.host.GetUrlRPC()
+	if err != nil {
+		return false
+	}
+
+	referenceURL, err := state.reference.GetUrlRPC()
+	if err != nil {
+		return false
+	}
+
+	return hostURL == referenceURL
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/mum4k/termdash/container"
 	"github.com/mum4k/termdash/container/grid"
 
+	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
 )
 
@@ -14,7 +15,8 @@ import (
 // configurations from the `dashboards` package and using them to build a new
 // `grid` with the `grid.New()` method. It then uses the built grid to create a
 // new dashboard using the `container.New()` method. The dashboard instance is
-// stored in the `db.dashboard` field for later use.
+// stored in the `db.dashboard` field for later use, its root container is
+// updated with the new layout once the dashboard is switched.
 func (db *Builder) Init() (err error) {
 	// Use a deferred function to call db.TearDown() if there were errors or panics
 	defer func() {
@@ -32,54 +34,82 @@ func (db *Builder) Init() (err error) {
 		}
 	}()
 
-	cellsConfig, err := dashboards.GetCellsConfig(db.tableType)
+	layout, err := db.initLayout()
 	if err != nil {
 		return err
 	}
 
-	cells, err := dashboards.GetCells(cellsConfig)
+	dashboardConfig := make([]container.Option, 0, len(dashboards.DashboardConfigDefault)+len(layout)+1)
+	dashboardConfig = append(dashboardConfig, dashboards.DashboardConfigDefault...)
+	dashboardConfig = append(dashboardConfig, container.ID(rootContainerID))
+	dashboardConfig = append(dashboardConfig, layout...)
+
+	dashboard, err := container.New(db.terminal, dashboardConfig...)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to initialize dashboard: %w", err)
 	}
 
-	db.cells = cells
+	db.dashboard = dashboard
 
-	columnsConfig, err := dashboards.GetColumnsConfig(db.tableType)
-	if err != nil {
-		return err
+	return nil
+}
+
+// initLayout creates the cells or the tiles of the dashboard type and lays them out in the grid.
+// It returns the options of the root container titled with the dashboard type and the host, which are
+// kept to restore the layout once the help is hidden.
+func (db *Builder) initLayout() ([]container.Option, error) {
+	var (
+		rows dashboards.Rows
+		err  error
+	)
+
+	if db.tableType == enums.TableTypeFleet {
+		rows, err = db.initFleetRows()
+	} else {
+		rows, err = db.initRows()
 	}
 
-	columns, err := dashboards.GetColumns(columnsConfig, cells)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rowsConfig, err := dashboards.GetRowsConfig(db.tableType)
+	builder := grid.New()
+	builder.Add(rows...)
+
+	options, err := builder.Build()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rows, err := dashboards.GetRows(rowsConfig, cells, columns)
+	db.layout = append([]container.Option{container.BorderTitle(dashboards.DashboardTitle(db.tableType, db.host))}, options...)
+
+	return db.layout, nil
+}
+
+// initRows creates the cells of the dashboard and lays them out in the rows of the grid
+// according to the layout of the dashboard type, the layout of the configuration or the built-in one.
+func (db *Builder) initRows() (dashboards.Rows, error) {
+	layout, err := dashboards.GetLayout(db.tableType, db.host, db.layouts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	builder := grid.New()
-	builder.Add(rows...)
-
-	options, err := builder.Build()
+	cells, err := dashboards.GetCells(layout.Cells, layout.Widgets)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	dashboardConfig := append(dashboards.DashboardConfigDefault, options...)
+	db.cells = cells
 
-	dashboard, err := container.New(db.terminal, dashboardConfig...)
+	columns, err := dashboards.GetColumns(layout.Columns, cells)
 	if err != nil {
-		return fmt.Errorf("failed to initialize dashboard: %w", err)
+		return nil, err
 	}
 
-	db.dashboard = dashboard
+	rows, err := dashboards.GetRows(layout.Rows, cells, columns)
+	if err != nil {
+		return nil, err
+	}
 
-	return nil
+	return rows, nil
 }
@@ -0,0 +1,257 @@
+package dashboardbuilder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mum4k/termdash/container"
+	"github.com/mum4k/termdash/keyboard"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+	"github.com/mum4k/termdash/widgets/text"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
+)
+
+const (
+	// rootContainerID is the identifier of the root container, which is updated once the dashboard is switched.
+	rootContainerID = "root"
+
+	helpLineFormat = "  %-16s%s\n"
+)
+
+// Dashboard holds the dashboard type the running dashboard can be switched to along with the hosts it renders
+// and the hosts it fails over to. The dashboards of the specific hosts are switched between their hosts,
+// the fleet dashboard renders all of its hosts at once.
+type Dashboard struct {
+	TableType enums.TableType
+	Hosts     []host.Host
+	Fallbacks []host.Host
+
+	// selected holds the index of the host rendered once the dashboard is switched to.
+	selected int
+}
+
+// SetDashboards sets the dashboards the running dashboard can be switched to from the keyboard.
+// The dashboard of the builder type and the host it renders are selected, the dashboards without hosts are skipped.
+func (db *Builder) SetDashboards(dashboards []Dashboard) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.viewLock.Lock()
+	defer db.viewLock.Unlock()
+
+	db.dashboards = make([]Dashboard, 0, len(dashboards))
+	db.dashboardIdx = 0
+
+	for _, dashboard := range dashboards {
+		if len(dashboard.Hosts) == 0 {
+			continue
+		}
+
+		if dashboard.TableType == db.tableType {
+			db.dashboardIdx = len(db.dashboards)
+
+			// the fleet hosts polled so far are kept, so the fleet is rendered with their latest metrics once switched back to
+			if dashboard.TableType == enums.TableTypeFleet {
+				dashboard.Hosts = db.fleet
+			}
+
+			for idx := range dashboard.Hosts {
+				if dashboard.Hosts[idx].Key() == db.host.Key() {
+					dashboard.selected = idx
+				}
+			}
+		}
+
+		db.dashboards = append(db.dashboards, dashboard)
+	}
+}
+
+// SetLayouts sets the layouts of the configuration, the dashboards missing in them are laid out with the built-in layouts.
+// The layouts are applied once the dashboard is initialized or switched to.
+func (db *Builder) SetLayouts(layouts map[enums.TableType]dashboards.Layout) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.viewLock.Lock()
+	defer db.viewLock.Unlock()
+
+	db.layouts = layouts
+}
+
+// keyboard handles the keys pressed on the dashboard: quits on Q or Esc, switches to the next dashboard
+// on Tab or to the numbered one on the number keys, switches between the hosts of the dashboard on the left
+// and right arrows and shows the help on H. The up and down arrows are left for scrolling the text cells.
+func (db *Builder) keyboard(k *terminalapi.Keyboard) {
+	db.quitter(k)
+
+	var err error
+
+	switch k.Key {
+	case 'h', 'H', '?':
+		err = db.toggleHelp()
+	case keyboard.KeyTab:
+		err = db.switchDashboard(1, 0)
+	case keyboard.KeyArrowRight:
+		err = db.switchDashboard(0, 1)
+	case keyboard.KeyArrowLeft:
+		err = db.switchDashboard(0, -1)
+	default:
+		if k.Key >= '1' && k.Key <= '9' {
+			err = db.selectDashboard(int(k.Key - '1'))
+		}
+	}
+
+	if err != nil {
+		db.viewLock.Lock()
+		db.viewErr = fmt.Errorf("failed to switch dashboard: %w", err)
+		db.viewLock.Unlock()
+	}
+}
+
+// switchDashboard moves by the offsets from the rendered dashboard and from its rendered host, wrapping around.
+// Nothing is switched if there is nowhere to move, the hosts of the fleet dashboard are not switched.
+func (db *Builder) switchDashboard(dashboardOffset, hostOffset int) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if len(db.dashboards) == 0 {
+		return nil
+	}
+
+	if dashboardOffset != 0 {
+		if len(db.dashboards) == 1 {
+			return nil
+		}
+
+		return db.rebuild((db.dashboardIdx+dashboardOffset)%len(db.dashboards), 0)
+	}
+
+	dashboard := db.dashboards[db.dashboardIdx]
+	if dashboard.TableType == enums.TableTypeFleet || len(dashboard.Hosts) == 1 {
+		return nil
+	}
+
+	return db.rebuild(db.dashboardIdx, hostOffset)
+}
+
+// selectDashboard switches to the dashboard with the specified index, the indexes out of range are ignored.
+func (db *Builder) selectDashboard(dashboardIdx int) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if dashboardIdx >= len(db.dashboards) || dashboardIdx == db.dashboardIdx {
+		return nil
+	}
+
+	return db.rebuild(dashboardIdx, 0)
+}
+
+// rebuild switches the builder to the host of the dashboard with the specified index, moved by the offset from
+// the host rendered last on it, and lays the root container out anew. The host rendered so far is stored back
+// to its dashboard along with its fallback hosts, so it keeps its latest metrics, or the host it failed over to, once switched back to.
+// The state kept for the previous host is reset, so the metrics of the host are requested on the next poll,
+// and the help is hidden. The caller must hold db.lock.
+func (db *Builder) rebuild(dashboardIdx, hostOffset int) error {
+	db.viewLock.Lock()
+	defer db.viewLock.Unlock()
+
+	if current := &db.dashboards[db.dashboardIdx]; current.TableType != enums.TableTypeFleet {
+		current.Hosts[current.selected] = db.host
+		current.Fallbacks = db.fallbacks
+	}
+
+	dashboard := &db.dashboards[dashboardIdx]
+	dashboard.selected = (dashboard.selected + hostOffset + len(dashboard.Hosts)) % len(dashboard.Hosts)
+
+	db.generation++
+	db.dashboardIdx = dashboardIdx
+	db.tableType = dashboard.TableType
+	db.host = dashboard.Hosts[dashboard.selected]
+	db.fallbacks = append([]host.Host(nil), dashboard.Fallbacks...)
+	db.fleet = nil
+
+	if dashboard.TableType == enums.TableTypeFleet {
+		db.fleet = dashboard.Hosts
+	}
+
+	db.cells, db.tiles = nil, nil
+	db.epoch = ""
+	db.pushedAt = time.Time{}
+	db.helpShown = false
+
+	layout, err := db.initLayout()
+	if err != nil {
+		return err
+	}
+
+	return db.dashboard.Update(rootContainerID, append([]container.Option{container.Clear()}, layout...)...)
+}
+
+// toggleHelp shows the help listing the keyboard shortcuts and the dashboards in place of the dashboard,
+// or restores the dashboard if the help is shown.
+func (db *Builder) toggleHelp() error {
+	db.viewLock.Lock()
+	defer db.viewLock.Unlock()
+
+	if db.helpShown {
+		db.helpShown = false
+
+		return db.dashboard.Update(rootContainerID, append([]container.Option{container.Clear()}, db.layout...)...)
+	}
+
+	if db.help == nil {
+		help, err := text.New(text.WrapAtWords())
+		if err != nil {
+			return fmt.Errorf("failed to initialize help widget: %w", err)
+		}
+
+		db.help = help
+	}
+
+	db.help.Reset()
+
+	if err := db.help.Write(db.helpText()); err != nil {
+		return err
+	}
+
+	db.helpShown = true
+
+	return db.dashboard.Update(rootContainerID, container.PlaceWidget(db.help))
+}
+
+// helpText returns the keyboard shortcuts along with the numbered dashboards and the count of their hosts,
+// the rendered dashboard is marked. The caller must hold db.viewLock.
+func (db *Builder) helpText() string {
+	help := "\n  KEYBOARD SHORTCUTS\n\n" +
+		fmt.Sprintf(helpLineFormat, "TAB", "SWITCH TO THE NEXT DASHBOARD") +
+		fmt.Sprintf(helpLineFormat, "1-9", "SWITCH TO THE DASHBOARD BY ITS NUMBER") +
+		fmt.Sprintf(helpLineFormat, "LEFT, RIGHT", "SWITCH TO THE PREVIOUS OR THE NEXT HOST OF THE DASHBOARD") +
+		fmt.Sprintf(helpLineFormat, "UP, DOWN", "SCROLL THE TEXT CELL SELECTED WITH THE MOUSE, THE MOUSE WHEEL SCROLLS IT TOO") +
+		fmt.Sprintf(helpLineFormat, "H, ?", "SHOW OR HIDE THIS HELP") +
+		fmt.Sprintf(helpLineFormat, "Q, ESC", "QUIT")
+
+	if len(db.dashboards) == 0 {
+		return help + "\n  NO OTHER DASHBOARDS TO SWITCH TO\n"
+	}
+
+	help += "\n  DASHBOARDS\n\n"
+
+	for idx, dashboard := range db.dashboards {
+		marker := " "
+		if idx == db.dashboardIdx {
+			marker = ">"
+		}
+
+		hosts := fmt.Sprintf("%d HOST(S)", len(dashboard.Hosts))
+		if dashboard.TableType != enums.TableTypeFleet && len(dashboard.Hosts) > 1 {
+			hosts += ", RENDERING " + dashboard.Hosts[dashboard.selected].HostAddress()
+		}
+
+		help += fmt.Sprintf("%s %d  %s: %s\n", marker, idx+1, dashboard.TableType, hosts)
+	}
+
+	return help
+}
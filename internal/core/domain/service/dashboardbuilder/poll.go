@@ -0,0 +1,81 @@
+package dashboardbuilder
+
+import (
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+)
+
+// pollState holds the copy of the state of the dashboard the metrics are requested for. The requests are sent
+// on the copy without holding db.lock, so switching the dashboard from the keyboard is not blocked by them.
+type pollState struct {
+	generation   int
+	tableType    enums.TableType
+	host         host.Host
+	reference    host.Host
+	referencedAt time.Time
+	fallbacks    []host.Host
+	fleet        []host.Host
+	epoch        string
+}
+
+// snapshot copies the state of the dashboard the metrics are requested for. The copied hosts share the histories
+// of their counters with the dashboard, which is safe since the samples are always appended to a new copy of the history.
+// The caller must hold db.lock.
+func (db *Builder) snapshot() *pollState {
+	return &pollState{
+		generation:   db.generation,
+		tableType:    db.tableType,
+		host:         db.host,
+		reference:    db.reference,
+		referencedAt: db.referencedAt,
+		fallbacks:    append([]host.Host(nil), db.fallbacks...),
+		fleet:        append([]host.Host(nil), db.fleet...),
+		epoch:        db.epoch,
+	}
+}
+
+// commit stores the state updated by the requests unless the dashboard was switched or another update was stored
+// since the state was copied, the outdated state is dropped. The rendered hosts are replaced holding db.viewLock,
+// so the dashboard is never rendered with a partially updated host, and the dashboard is retitled once it failed over.
+func (db *Builder) commit(state *pollState) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.isOutdated(state) {
+		return nil
+	}
+
+	db.viewLock.Lock()
+	defer db.viewLock.Unlock()
+
+	failedOver := db.host.Key() != state.host.Key()
+
+	// the counters sampled on the notifications are kept while the subscription delivers them, the polled ones are used once it stops
+	if !failedOver && db.isPushed() {
+		state.host.Metrics.CopyCounters(db.host.Metrics)
+	}
+
+	db.generation++
+	db.host = state.host
+	db.reference = state.reference
+	db.referencedAt = state.referencedAt
+	db.fallbacks = state.fallbacks
+	db.epoch = state.epoch
+
+	// the fleet hosts are copied in place, since they are shared with the fleet dashboard the keyboard switches to
+	copy(db.fleet, state.fleet)
+
+	if failedOver {
+		return db.retitle()
+	}
+
+	return nil
+}
+
+// isOutdated checks whether the dashboard was switched or another update was stored since the state was copied.
+// The caller must hold db.lock.
+func (db *Builder) isOutdated(state *pollState) bool {
+	return state.generation != db.generation
+}
@@ -3,11 +3,15 @@ package dashboardbuilder
 import (
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/mum4k/termdash"
+	"github.com/mum4k/termdash/container"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
 	"github.com/bartosian/suimon/internal/core/domain/service/dashboardbuilder/dashboards"
 )
 
@@ -44,7 +48,7 @@ func (db *Builder) Render() (err error) {
 		for {
 			select {
 			case <-tickerQuery.C:
-				if err := db.host.GetMetrics(); err != nil {
+				if err := db.poll(); err != nil {
 					return err
 				}
 			case <-db.ctx.Done():
@@ -53,6 +57,23 @@ func (db *Builder) Render() (err error) {
 		}
 	})
 
+	// Start the goroutines sampling the counters on the notifications of the host, the polling is used as the fallback
+	if db.subscription != nil {
+		notifications := make(chan time.Time, 1)
+
+		errGroup.Go(func() error {
+			db.subscribe(notifications)
+
+			return nil
+		})
+
+		errGroup.Go(func() error {
+			db.push(notifications)
+
+			return nil
+		})
+	}
+
 	tickerRerender := time.NewTicker(renderInterval)
 	defer tickerRerender.Stop()
 
@@ -61,21 +82,9 @@ func (db *Builder) Render() (err error) {
 		for {
 			select {
 			case <-tickerRerender.C:
-				columnValues, err := dashboards.GetColumnsValues(db.tableType, db.host)
-				if err != nil {
+				if err := db.write(); err != nil {
 					return err
 				}
-
-				for columnName, cell := range db.cells {
-					columnValue, ok := columnValues[columnName]
-					if !ok {
-						return fmt.Errorf("failed to get metric for column %s", columnName)
-					}
-
-					if err := cell.Write(columnValue); err != nil {
-						return err
-					}
-				}
 			case <-db.ctx.Done():
 				return nil
 			}
@@ -86,7 +95,7 @@ func (db *Builder) Render() (err error) {
 		// Display the dashboard on the terminal and handle errors
 		if err := termdash.Run(
 			db.ctx, db.terminal, db.dashboard,
-			termdash.KeyboardSubscriber(db.quitter),
+			termdash.KeyboardSubscriber(db.keyboard),
 		); err != nil {
 			return fmt.Errorf("failed to run terminal dashboard: %w", err)
 		}
@@ -96,3 +105,139 @@ func (db *Builder) Render() (err error) {
 
 	return errGroup.Wait()
 }
+
+// write writes the latest values of the hosts to the dashboard.
+// The error of the last switch of the dashboard is returned, so it stops the rendering.
+func (db *Builder) write() error {
+	db.viewLock.Lock()
+	defer db.viewLock.Unlock()
+
+	if db.viewErr != nil {
+		return db.viewErr
+	}
+
+	if db.tableType == enums.TableTypeFleet {
+		return db.writeTiles()
+	}
+
+	return db.writeCells()
+}
+
+// writeCells writes the values of the host to the cells of the dashboard.
+func (db *Builder) writeCells() error {
+	columnValues, err := dashboards.GetColumnsValues(db.tableType, db.host)
+	if err != nil {
+		return err
+	}
+
+	for columnName, cell := range db.cells {
+		columnValue, ok := columnValues[columnName]
+		if !ok {
+			return fmt.Errorf("failed to get metric for column %s", columnName)
+		}
+
+		if err := cell.Write(columnValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// poll requests the metrics of the host, failing over to the fallback hosts if it does not respond.
+// The requests are sent on the copy of the state, so db.lock is not held during them, and their results,
+// as well as their errors, are dropped if the dashboard is switched meanwhile.
+func (db *Builder) poll() error {
+	db.lock.Lock()
+
+	state := db.snapshot()
+
+	db.lock.Unlock()
+
+	if err := state.poll(); err != nil {
+		db.lock.Lock()
+		defer db.lock.Unlock()
+
+		if db.isOutdated(state) {
+			return nil
+		}
+
+		return err
+	}
+
+	return db.commit(state)
+}
+
+// poll requests the metrics of the copied state according to the type of the dashboard.
+func (state *pollState) poll() error {
+	switch state.tableType {
+	case enums.TableTypeEpochsHistory:
+		return state.pollEpochs()
+	case enums.TableTypeFleet:
+		return state.pollFleet()
+	}
+
+	if err := state.host.GetMetrics(); err != nil {
+		if err := state.failover(err); err != nil {
+			return err
+		}
+	}
+
+	return state.checkHealth()
+}
+
+// failover switches the state to the most advanced fallback host which responds. The fallback hosts are refreshed in parallel
+// and ranked by their progress again, the host failing to respond takes the place of the selected one, so the dashboard can fail
+// over back to it once it recovers. The original error is returned if none of the fallback hosts responds.
+func (state *pollState) failover(err error) error {
+	if len(state.fallbacks) == 0 {
+		return err
+	}
+
+	var wg sync.WaitGroup
+
+	for idx := range state.fallbacks {
+		wg.Add(1)
+
+		go func(idx int) {
+			defer wg.Done()
+
+			if err := state.fallbacks[idx].GetMetrics(); err != nil {
+				state.fallbacks[idx].Metrics.Updated = false
+			}
+		}(idx)
+	}
+
+	wg.Wait()
+
+	host.SortByProgress(state.fallbacks)
+
+	if !state.fallbacks[0].Metrics.Updated {
+		return err
+	}
+
+	failed := state.host
+	failed.Metrics.Updated = false
+
+	state.host, state.fallbacks[0] = state.fallbacks[0], failed
+
+	host.SortByProgress(state.fallbacks)
+
+	return nil
+}
+
+// retitle titles the root container with the host the dashboard switched to, the title is kept in the layout
+// as well, so it is restored once the help is hidden. The caller must hold db.viewLock.
+func (db *Builder) retitle() error {
+	title := container.BorderTitle(dashboards.DashboardTitle(db.tableType, db.host))
+
+	if len(db.layout) > 0 {
+		db.layout[0] = title
+	}
+
+	if db.dashboard == nil {
+		return nil
+	}
+
+	return db.dashboard.Update(rootContainerID, title)
+}
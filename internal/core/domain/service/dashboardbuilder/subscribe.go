@@ -0,0 +1,124 @@
+package dashboardbuilder
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+	"github.com/bartosian/suimon/internal/pkg/retry"
+)
+
+const (
+	// pushInterval is the minimum interval between the counters sampled on the notifications of the host.
+	pushInterval = time.Second
+	// resubscribeBackoff is the base delay before subscribing again after the subscription is dropped.
+	resubscribeBackoff = time.Second
+)
+
+// eventsFilter matches all events emitted on the network. The Sui RPC does not stream the checkpoints,
+// the arrival of the events signals that new transactions were executed.
+var eventsFilter = []any{map[string]any{"All": []any{}}}
+
+// subscribe keeps the host subscribed to the events and passes the arrival time of the first notification of every push interval
+// on the notifications channel. The rest of the notifications are dropped, so the counters are sampled at most once per push interval
+// however many events the network emits. The dropped subscription is restored with a growing delay, the dashboard keeps being
+// polled in the meantime.
+func (db *Builder) subscribe(notifications chan<- time.Time) {
+	var (
+		attempt   int
+		sampledAt time.Time
+	)
+
+	for {
+		var notified bool
+
+		// The error is not reported, since the dashboard falls back to polling while the subscription is down.
+		_ = db.subscription.Subscribe(db.ctx, enums.RPCMethodSubscribeEvent, eventsFilter, func(json.RawMessage) {
+			notified = true
+
+			arrivedAt := time.Now()
+			if arrivedAt.Sub(sampledAt) < pushInterval {
+				return
+			}
+
+			sampledAt = arrivedAt
+
+			select {
+			case notifications <- arrivedAt:
+			default:
+			}
+		})
+
+		if notified {
+			attempt = 0
+		}
+
+		select {
+		case <-time.After(retry.Delay(resubscribeBackoff, attempt)):
+			attempt++
+		case <-db.ctx.Done():
+			return
+		}
+	}
+}
+
+// push samples the counters of the host at the arrival time of the notifications, so the transactions and checkpoints per second
+// and their sparklines follow the arrival of the data rather than the polling interval. The counters are requested on the copy
+// of the host without holding db.lock, the rest of the metrics are left to the polling. The failing requests are left to the polling
+// as well, so the host is reported and failed over as usual. The notifications are ignored while the dashboard is switched to another host.
+func (db *Builder) push(notifications <-chan time.Time) {
+	for {
+		select {
+		case arrivedAt := <-notifications:
+			db.lock.Lock()
+
+			if !db.isSubscribed() {
+				db.lock.Unlock()
+
+				continue
+			}
+
+			pushed := db.host
+
+			db.lock.Unlock()
+
+			if err := pushed.GetCounters(arrivedAt); err != nil {
+				continue
+			}
+
+			db.storeCounters(pushed, arrivedAt)
+		case <-db.ctx.Done():
+			return
+		}
+	}
+}
+
+// storeCounters stores the counters sampled on the notification into the host rendered on the dashboard,
+// unless the dashboard was switched to another host meanwhile.
+func (db *Builder) storeCounters(pushed host.Host, pushedAt time.Time) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if !db.isSubscribed() {
+		return
+	}
+
+	db.viewLock.Lock()
+	defer db.viewLock.Unlock()
+
+	db.host.Metrics.CopyCounters(pushed.Metrics)
+	db.pushedAt = pushedAt
+}
+
+// isPushed checks whether the counters of the host were sampled on a notification within the query interval, in which case
+// the polled counters are dropped, so the rates are calculated from the samples taken on the notifications only.
+// The caller must hold db.lock.
+func (db *Builder) isPushed() bool {
+	return db.isSubscribed() && time.Since(db.pushedAt) < queryInterval
+}
+
+// isSubscribed checks whether the dashboard renders the host the subscription is opened for.
+func (db *Builder) isSubscribed() bool {
+	return db.tableType == db.subscribedTo && db.host.Key() == db.subscribedKey
+}
@@ -0,0 +1,106 @@
+package exporter
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "suimon"
+
+var (
+	hostLabels     = []string{"network", "table", "host"}
+	gasPriceLabels = []string{"network", "host", "stat"}
+)
+
+type (
+	// Gauges holds the gauges exported for every monitored host.
+	Gauges struct {
+		status                *prometheus.GaugeVec
+		txSyncPercentage      *prometheus.GaugeVec
+		checkSyncPercentage   *prometheus.GaugeVec
+		checkpointExecBacklog *prometheus.GaugeVec
+		checkpointSyncBacklog *prometheus.GaugeVec
+		transactionsPerSecond *prometheus.GaugeVec
+		checkpointsPerSecond  *prometheus.GaugeVec
+		referenceGasPrice     *prometheus.GaugeVec
+		referenceRPC          *prometheus.GaugeVec
+		lastUpdate            *prometheus.GaugeVec
+	}
+
+	// Exporter exposes the health and the derived metrics computed by suimon as Prometheus gauges.
+	Exporter struct {
+		network  string
+		registry *prometheus.Registry
+		gauges   Gauges
+
+		// failedAttempts counts the failed requests to the hosts. The hosts report the number of their failed requests
+		// since they were created, reportedAttempts holds the number reported on the previous update of every host,
+		// so the counter is increased by the requests failed since then.
+		failedAttempts   *prometheus.CounterVec
+		reportedAttempts map[string]int
+	}
+)
+
+// NewExporter creates a new Exporter for the specified network and registers its gauges.
+func NewExporter(network string) *Exporter {
+	newHostGauge := func(name, help string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      name,
+			Help:      help,
+		}, hostLabels)
+	}
+
+	gauges := Gauges{
+		status:                newHostGauge("host_status", "Health status of the host: 0 - unknown, 1 - green, 2 - yellow, 3 - red."),
+		txSyncPercentage:      newHostGauge("tx_sync_percentage", "Total transaction blocks of the host relative to the reference RPC, in percent."),
+		checkSyncPercentage:   newHostGauge("checkpoint_sync_percentage", "Highest synced checkpoint of the host relative to the reference RPC, in percent."),
+		checkpointExecBacklog: newHostGauge("checkpoint_exec_backlog", "Number of known checkpoints not executed by the host yet."),
+		checkpointSyncBacklog: newHostGauge("checkpoint_sync_backlog", "Number of known checkpoints not synced by the host yet."),
+		transactionsPerSecond: newHostGauge("transactions_per_second", "Transactions per second processed by the host."),
+		checkpointsPerSecond:  newHostGauge("checkpoints_per_second", "Checkpoints per second synced by the host."),
+		lastUpdate:            newHostGauge("last_update_timestamp_seconds", "Unix time of the last successful metrics update of the host."),
+		referenceRPC:          newHostGauge("reference_rpc", "Set to 1 for the RPC host selected as the reference for the health of the other hosts."),
+		referenceGasPrice: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "reference_gas_price",
+			Help:      "Reference gas price statistics of the active validators.",
+		}, gasPriceLabels),
+	}
+
+	failedAttempts := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "failed_attempts_total",
+		Help:      "Total number of the failed requests to the host, including the retried ones.",
+	}, hostLabels)
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		gauges.status,
+		gauges.txSyncPercentage,
+		gauges.checkSyncPercentage,
+		gauges.checkpointExecBacklog,
+		gauges.checkpointSyncBacklog,
+		gauges.transactionsPerSecond,
+		gauges.checkpointsPerSecond,
+		gauges.lastUpdate,
+		gauges.referenceGasPrice,
+		gauges.referenceRPC,
+		failedAttempts,
+	)
+
+	return &Exporter{
+		network:          network,
+		registry:         registry,
+		gauges:           gauges,
+		failedAttempts:   failedAttempts,
+		reportedAttempts: make(map[string]int),
+	}
+}
+
+// Handler returns the HTTP handler serving the exported metrics.
+func (e *Exporter) Handler() http.Handler {
+	return promhttp.HandlerFor(e.registry, promhttp.HandlerOpts{})
+}
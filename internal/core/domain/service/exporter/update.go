@@ -0,0 +1,110 @@
+package exporter
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
+)
+
+// statusToValue maps the host status to the exported gauge value.
+var statusToValue = map[enums.Status]float64{
+	enums.StatusGreen:  1,
+	enums.StatusYellow: 2,
+	enums.StatusRed:    3,
+}
+
+// Update sets the gauges of the specified table for the provided hosts.
+func (e *Exporter) Update(table enums.TableType, hosts []host.Host) {
+	for idx := range hosts {
+		e.updateHost(table, &hosts[idx])
+	}
+}
+
+// updateHost sets the gauges for a single host.
+func (e *Exporter) updateHost(table enums.TableType, host *host.Host) {
+	labels := prometheus.Labels{
+		"network": e.network,
+		"table":   table.Alias(),
+		"host":    host.HostAddress(),
+	}
+
+	metrics := host.Metrics
+	gauges := e.gauges
+
+	gauges.status.With(labels).Set(statusToValue[host.Status])
+	e.updateFailedAttempts(labels, host.FailedAttempts())
+
+	if table == enums.TableTypeRPC {
+		var reference float64
+		if host.Reference {
+			reference = 1
+		}
+
+		gauges.referenceRPC.With(labels).Set(reference)
+	}
+
+	if !metrics.Updated {
+		return
+	}
+
+	gauges.lastUpdate.With(labels).Set(float64(time.Now().Unix()))
+
+	// the transactions are reported by the JSON-RPC API, while the checkpoints are reported by the metrics endpoint.
+	if table != enums.TableTypeValidator {
+		gauges.txSyncPercentage.With(labels).Set(float64(metrics.TxSyncPercentage))
+		gauges.transactionsPerSecond.With(labels).Set(float64(metrics.TransactionsPerSecond))
+	}
+
+	if table != enums.TableTypeRPC {
+		gauges.checkSyncPercentage.With(labels).Set(float64(metrics.CheckSyncPercentage))
+		gauges.checkpointsPerSecond.With(labels).Set(float64(metrics.CheckpointsPerSecond))
+		gauges.checkpointExecBacklog.With(labels).Set(float64(metrics.CheckpointExecBacklog))
+		gauges.checkpointSyncBacklog.With(labels).Set(float64(metrics.CheckpointSyncBacklog))
+	}
+}
+
+// updateFailedAttempts increases the counter of the failed requests of the host by the requests failed since the previous update.
+// The host reporting fewer failed requests than before was created again, so all the requests it reports failed since then.
+func (e *Exporter) updateFailedAttempts(labels prometheus.Labels, failed int) {
+	key := labels["table"] + "/" + labels["host"]
+
+	increase := failed - e.reportedAttempts[key]
+	if increase < 0 {
+		increase = failed
+	}
+
+	e.reportedAttempts[key] = failed
+
+	e.failedAttempts.With(labels).Add(float64(increase))
+}
+
+// UpdateGasPrice sets the reference gas price statistics reported by the reference RPC host.
+func (e *Exporter) UpdateGasPrice(rpcHost *host.Host) {
+	gasPrice := rpcHost.Metrics.GasPrice
+
+	if !rpcHost.Metrics.Updated || gasPrice.MinReferenceGasPrice == 0 {
+		return
+	}
+
+	stats := map[string]int{
+		"min":                 gasPrice.MinReferenceGasPrice,
+		"max":                 gasPrice.MaxReferenceGasPrice,
+		"mean":                gasPrice.MeanReferenceGasPrice,
+		"stake_weighted_mean": gasPrice.StakeWeightedMeanReferenceGasPrice,
+		"median":              gasPrice.MedianReferenceGasPrice,
+		"estimated_next":      gasPrice.EstimatedNextReferenceGasPrice,
+	}
+
+	e.gauges.referenceGasPrice.Reset()
+
+	for stat, value := range stats {
+		e.gauges.referenceGasPrice.With(prometheus.Labels{
+			"network": e.network,
+			"host":    rpcHost.HostAddress(),
+			"stat":    stat,
+		}).Set(float64(value))
+	}
+}
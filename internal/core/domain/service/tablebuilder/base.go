@@ -2,7 +2,7 @@ package tablebuilder
 
 import (
 	"errors"
-	"os"
+	"io"
 	"strconv"
 
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -22,10 +22,11 @@ type Builder struct {
 	config     *tables.TableConfig
 }
 
-// NewBuilder creates a new instance of the table builder, using the CLI gateway
-func NewBuilder(tableType enums.TableType, hosts []host.Host, cliGateway *cligw.Gateway) *Builder {
+// NewBuilder creates a new instance of the table builder, using the CLI gateway.
+// The rendered table is written to the provided output.
+func NewBuilder(tableType enums.TableType, hosts []host.Host, output io.Writer, cliGateway *cligw.Gateway) *Builder {
 	tableWR := table.NewWriter()
-	tableWR.SetOutputMirror(os.Stdout)
+	tableWR.SetOutputMirror(output)
 
 	return &Builder{
 		tableType:  tableType,
@@ -70,7 +71,7 @@ func (tb *Builder) setRows() error {
 				columnValue := columnConfig.Values[itemIndex]
 
 				header.AppendValue(columnName.ToString())
-				row.AppendValue(columnValue)
+				row.AppendValue(tables.FormatValue(columnName, columnValue))
 				footer.PrependValue(tables.EmptyValue)
 			}
 
@@ -83,7 +84,7 @@ func (tb *Builder) setRows() error {
 			if itemIndex == 0 && rowIndex == 0 {
 				tb.writer.AppendHeader(header.Values, header.Config)
 				tb.writer.AppendFooter(footer.Values, footer.Config)
-			} else if len(rowsConfig) > 1 && (rowIndex%2 == 1 || itemIndex > 0 && rowIndex%2 == 0) {
+			} else if len(rowsConfig) > 1 {
 				tb.writer.AppendRow(header.Values, header.Config)
 			}
 
@@ -112,6 +113,7 @@ func (tb *Builder) setColors() {
 		fgBlack  = text.FgBlack
 		bgRed    = text.BgRed
 		bgYellow = text.BgYellow
+		bgGreen  = text.BgGreen
 	)
 
 	var painter = func() func(row table.Row) text.Colors {
@@ -140,6 +142,13 @@ func (tb *Builder) setColors() {
 					return text.Colors{bgYellow, fgBlack}
 				}
 
+				return valuesRowFgColor
+			case enums.TableTypeActiveValidators:
+				// the tracked validators are highlighted, the flag is rendered in the column next to the index
+				if tables.IsChecked(row[1]) {
+					return text.Colors{bgGreen, fgBlack}
+				}
+
 				return valuesRowFgColor
 			default:
 				for _, column := range row {
@@ -0,0 +1,190 @@
+package tablebuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/service/tablebuilder/tables"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// carriedColumns holds the columns which are left blank in the continuation rows of a table
+// and have to be carried over from the previous record in structured output.
+var carriedColumns = map[enums.TableType][]enums.ColumnName{
+	enums.TableTypeValidatorReports: {
+		enums.ColumnNameSystemValidatorReportedName,
+		enums.ColumnNameSystemValidatorSlashingPercentage,
+	},
+}
+
+type jsonTable struct {
+	Table string           `json:"table"`
+	Title string           `json:"title"`
+	Rows  []map[string]any `json:"rows"`
+}
+
+// Records returns the rows of the table as structured records keyed by the column names.
+// The values are kept raw, without colors and formatting applied for the terminal output.
+func (tb *Builder) Records() ports.TableRecords {
+	records := ports.TableRecords{
+		Table:   tb.tableType,
+		Columns: tb.columnNames(),
+	}
+
+	if tb.config == nil {
+		return records
+	}
+
+	records.Rows = make([]ports.TableRecord, 0, tb.config.RowsCount)
+
+	var previous ports.TableRecord
+
+	for itemIndex := 0; itemIndex < tb.config.RowsCount; itemIndex++ {
+		record := make(ports.TableRecord, len(records.Columns))
+
+		for _, columnName := range records.Columns {
+			column, ok := tb.config.Columns[columnName]
+			if !ok || itemIndex >= len(column.Values) {
+				record[columnName] = nil
+
+				continue
+			}
+
+			record[columnName] = tables.RawValue(columnName, column.Values[itemIndex])
+		}
+
+		for _, columnName := range carriedColumns[tb.tableType] {
+			if record[columnName] == nil && previous != nil {
+				record[columnName] = previous[columnName]
+			}
+		}
+
+		records.Rows = append(records.Rows, record)
+		previous = record
+	}
+
+	return records
+}
+
+// columnNames returns the unique column names of the table in the order they are rendered.
+func (tb *Builder) columnNames() []enums.ColumnName {
+	if tb.config == nil {
+		return nil
+	}
+
+	columnNames := make([]enums.ColumnName, 0, tb.config.ColumnsCount)
+	processedColumns := make(map[enums.ColumnName]bool, tb.config.ColumnsCount)
+
+	for _, columns := range tb.config.Rows {
+		for _, columnName := range columns {
+			if processedColumns[columnName] {
+				continue
+			}
+
+			processedColumns[columnName] = true
+			columnNames = append(columnNames, columnName)
+		}
+	}
+
+	return columnNames
+}
+
+// WriteRecords writes the tables records to the output in the specified format.
+// JSON output is a single document with all tables, other formats render the tables one after another as flat tables.
+func WriteRecords(output io.Writer, format enums.OutputFormat, records []ports.TableRecords) error {
+	switch format {
+	case enums.OutputFormatJSON:
+		return writeJSON(output, records)
+	case enums.OutputFormatTable, enums.OutputFormatCSV, enums.OutputFormatMarkdown, enums.OutputFormatHTML:
+		for idx, tableRecords := range records {
+			if idx > 0 {
+				if _, err := fmt.Fprintln(output); err != nil {
+					return err
+				}
+			}
+
+			renderRecords(output, format, tableRecords)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// writeJSON writes the tables records to the output as an indented JSON document.
+func writeJSON(output io.Writer, records []ports.TableRecords) error {
+	jsonTables := make([]jsonTable, 0, len(records))
+
+	for _, tableRecords := range records {
+		rows := make([]map[string]any, 0, len(tableRecords.Rows))
+
+		for _, record := range tableRecords.Rows {
+			row := make(map[string]any, len(record))
+
+			for columnName, value := range record {
+				row[columnName.ToLabel()] = value
+			}
+
+			rows = append(rows, row)
+		}
+
+		jsonTables = append(jsonTables, jsonTable{
+			Table: tableRecords.Table.Alias(),
+			Title: tableRecords.Table.ToString(),
+			Rows:  rows,
+		})
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(jsonTables)
+}
+
+// renderRecords renders the table records to the output as a flat table in the specified format.
+func renderRecords(output io.Writer, format enums.OutputFormat, records ports.TableRecords) {
+	tableWR := table.NewWriter()
+	tableWR.SetOutputMirror(output)
+	tableWR.SetTitle(records.Table.ToString())
+
+	header := make(table.Row, 0, len(records.Columns))
+	for _, columnName := range records.Columns {
+		header = append(header, columnName.ToLabel())
+	}
+
+	tableWR.AppendHeader(header)
+
+	for _, record := range records.Rows {
+		row := make(table.Row, 0, len(records.Columns))
+
+		for _, columnName := range records.Columns {
+			value := record[columnName]
+			if value == nil {
+				value = tables.EmptyValue
+			}
+
+			row = append(row, value)
+		}
+
+		tableWR.AppendRow(row)
+	}
+
+	switch format {
+	case enums.OutputFormatTable:
+		tableWR.SetTitle(tables.GetTableName(records.Table))
+		tableWR.SetStyle(tables.GetTableStyle(records.Table))
+		tableWR.Render()
+	case enums.OutputFormatCSV:
+		tableWR.RenderCSV()
+	case enums.OutputFormatMarkdown:
+		tableWR.RenderMarkdown()
+	case enums.OutputFormatHTML:
+		tableWR.RenderHTML()
+	}
+}
@@ -10,6 +10,7 @@ import (
 var (
 	ColumnsConfigActiveValidator = ColumnsConfig{
 		enums.ColumnNameIndex:                             NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorTracked:                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameValidatorName:                     NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
 		enums.ColumnNameValidatorNetAddress:               NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
 		enums.ColumnNameValidatorVotingPower:              NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
@@ -29,6 +30,7 @@ var (
 	RowsActiveValidator = RowsConfig{
 		0: {
 			enums.ColumnNameIndex,
+			enums.ColumnNameValidatorTracked,
 			enums.ColumnNameValidatorName,
 			enums.ColumnNameValidatorVotingPower,
 			enums.ColumnNameValidatorGasPrice,
@@ -51,6 +53,7 @@ var (
 func GetActiveValidatorColumnValues(idx int, validator *domainmetrics.Validator) (ColumnValues, error) {
 	result := ColumnValues{
 		enums.ColumnNameIndex:                             idx + 1,
+		enums.ColumnNameValidatorTracked:                  validator.Tracked,
 		enums.ColumnNameValidatorName:                     validator.Name,
 		enums.ColumnNameValidatorNetAddress:               validator.NetAddress,
 		enums.ColumnNameValidatorVotingPower:              validator.VotingPower,
@@ -73,24 +73,34 @@ type TableConfig struct {
 // NewDefaultTableConfig returns a new default table configuration based on the specified table type.
 // It sets the table name, style, sort, rows, columns, column count, and auto-index.
 func NewDefaultTableConfig(table enums.TableType) *TableConfig {
-	tableName := fmt.Sprintf("%s [ %s ]", suiEmoji, table)
 	columnsConfig := GetColumnsConfig(table)
 	rowsConfig := GetRowsConfig(table)
-	tableColor := GetTableColor(table)
-
-	tableStyle := tableStyleDefault
-	tableStyle.Title.Colors = tableColor
-	tableStyle.Color.Footer = tableColor
 
 	return &TableConfig{
-		Name:         tableName,
-		Style:        tableStyle,
+		Name:         GetTableName(table),
+		Style:        GetTableStyle(table),
 		Rows:         rowsConfig,
 		Columns:      columnsConfig,
 		ColumnsCount: len(columnsConfig),
 	}
 }
 
+// GetTableName returns the title of the table for the specified table type.
+func GetTableName(table enums.TableType) string {
+	return fmt.Sprintf("%s [ %s ]", suiEmoji, table)
+}
+
+// GetTableStyle returns the default table style colored for the specified table type.
+func GetTableStyle(table enums.TableType) table.Style {
+	tableColor := GetTableColor(table)
+
+	tableStyle := tableStyleDefault
+	tableStyle.Title.Colors = tableColor
+	tableStyle.Color.Footer = tableColor
+
+	return tableStyle
+}
+
 // GetColumnsConfig returns the columns configuration based on the specified table type.
 func GetColumnsConfig(table enums.TableType) ColumnsConfig {
 	switch table {
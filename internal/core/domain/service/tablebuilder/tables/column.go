@@ -1,16 +1,23 @@
 package tables
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/domain/host"
 )
 
 const (
 	TableNoData    = "no data"
 	EmptyValue     = ""
 	RpcPortDefault = "9000"
+
+	checkMark = "✔"
 )
 
 type (
@@ -76,3 +83,110 @@ func (cols ColumnsConfig) SetColumnValues(values ColumnValues) {
 		cols[idx] = newCol
 	}
 }
+
+// percentageColumns holds the columns whose values are rendered as percentages.
+var percentageColumns = map[enums.ColumnName]bool{
+	enums.ColumnNameTXSyncPercentage:    true,
+	enums.ColumnNameCheckSyncPercentage: true,
+}
+
+// FormatValue converts the raw column value into its table representation,
+// e.g. the health status into a colored placeholder, the flags into a check mark and the sync progress into a percentage.
+func FormatValue(columnName enums.ColumnName, value any) any {
+	if status, ok := value.(enums.Status); ok {
+		return status.StatusToPlaceholder()
+	}
+
+	if flag, ok := value.(bool); ok {
+		if flag {
+			return checkMark
+		}
+
+		return EmptyValue
+	}
+
+	if percentageColumns[columnName] && value != TableNoData {
+		return fmt.Sprintf("%v%%", value)
+	}
+
+	return value
+}
+
+// IsChecked reports whether the formatted column value is the check mark of a set flag.
+func IsChecked(value any) bool {
+	return value == checkMark
+}
+
+// textColumns holds the columns whose values are always kept as text in structured output.
+var textColumns = map[enums.ColumnName]bool{
+	enums.ColumnNameAddress:                        true,
+	enums.ColumnNameVersion:                        true,
+	enums.ColumnNameCommit:                         true,
+	enums.ColumnNameCountry:                        true,
+	enums.ColumnNameValidatorName:                  true,
+	enums.ColumnNameValidatorNetAddress:            true,
+	enums.ColumnNameSystemAtRiskValidatorName:      true,
+	enums.ColumnNameSystemAtRiskValidatorAddress:   true,
+	enums.ColumnNameSystemValidatorReporterName:    true,
+	enums.ColumnNameSystemValidatorReporterAddress: true,
+	enums.ColumnNameSystemValidatorReportedName:    true,
+}
+
+// RawValue converts the column value into its structured output representation:
+// the health status is converted into the color name, numeric strings into numbers
+// and missing values, including the empty list of the failed health checks, into nil.
+func RawValue(columnName enums.ColumnName, value any) any {
+	switch typedValue := value.(type) {
+	case enums.Status:
+		return typedValue.ToLabel()
+	case host.HealthChecks:
+		if len(typedValue) == 0 {
+			return nil
+		}
+
+		return typedValue
+	case string:
+		typedValue = strings.TrimSpace(typedValue)
+
+		if typedValue == "" || typedValue == TableNoData {
+			return nil
+		}
+
+		if textColumns[columnName] || !isNumeric(typedValue) {
+			return typedValue
+		}
+
+		if intValue, err := strconv.ParseInt(typedValue, 10, 64); err == nil {
+			return intValue
+		}
+
+		if floatValue, err := strconv.ParseFloat(typedValue, 64); err == nil {
+			return floatValue
+		}
+
+		return typedValue
+	default:
+		return value
+	}
+}
+
+// isNumeric checks whether the value consists of an optional sign, digits and at most one decimal point.
+func isNumeric(value string) bool {
+	value = strings.TrimPrefix(value, "-")
+	if value == "" {
+		return false
+	}
+
+	points := 0
+
+	for _, char := range value {
+		switch {
+		case char == '.':
+			points++
+		case char < '0' || char > '9':
+			return false
+		}
+	}
+
+	return points <= 1 && value != "."
+}
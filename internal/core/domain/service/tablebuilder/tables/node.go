@@ -1,8 +1,6 @@
 package tables
 
 import (
-	"fmt"
-
 	"github.com/jedib0t/go-pretty/v6/text"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
@@ -32,6 +30,8 @@ var (
 		enums.ColumnNameVersion:                      NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameCommit:                       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameCountry:                      NewDefaultColumnConfig(text.AlignCenter, text.AlignLeft, false),
+		enums.ColumnNameFailedAttempts:               NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameReason:                       NewDefaultColumnConfig(text.AlignCenter, text.AlignLeft, false),
 	}
 
 	RowsConfigNode = RowsConfig{
@@ -59,6 +59,8 @@ var (
 			enums.ColumnNameVersion,
 			enums.ColumnNameCommit,
 			enums.ColumnNameCountry,
+			enums.ColumnNameFailedAttempts,
+			enums.ColumnNameReason,
 		},
 	}
 )
@@ -68,7 +70,7 @@ var (
 // The function also includes emoji values in the map if the specified flag is true.
 // Returns a map of NodeColumnName keys to corresponding values.
 func GetNodeColumnValues(idx int, host host.Host) ColumnValues {
-	status := host.Status.StatusToPlaceholder()
+	status := host.Status
 
 	var country string
 	if host.IPInfo != nil {
@@ -97,13 +99,15 @@ func GetNodeColumnValues(idx int, host host.Host) ColumnValues {
 		enums.ColumnNameCheckpointExecBacklog:        host.Metrics.CheckpointExecBacklog,
 		enums.ColumnNameCheckpointSyncBacklog:        host.Metrics.CheckpointSyncBacklog,
 		enums.ColumnNameCurrentEpoch:                 host.Metrics.CurrentEpoch,
-		enums.ColumnNameTXSyncPercentage:             fmt.Sprintf("%v%%", host.Metrics.TxSyncPercentage),
-		enums.ColumnNameCheckSyncPercentage:          fmt.Sprintf("%v%%", host.Metrics.CheckSyncPercentage),
+		enums.ColumnNameTXSyncPercentage:             host.Metrics.TxSyncPercentage,
+		enums.ColumnNameCheckSyncPercentage:          host.Metrics.CheckSyncPercentage,
 		enums.ColumnNameNetworkPeers:                 host.Metrics.NetworkPeers,
 		enums.ColumnNameUptime:                       host.Metrics.Uptime,
 		enums.ColumnNameVersion:                      host.Metrics.Version,
 		enums.ColumnNameCommit:                       host.Metrics.Commit,
 		enums.ColumnNameCountry:                      country,
+		enums.ColumnNameFailedAttempts:               host.FailedAttempts(),
+		enums.ColumnNameReason:                       host.FailedChecks,
 	}
 
 	return columnValues
@@ -11,21 +11,27 @@ var (
 	ColumnsConfigRPC = ColumnsConfig{
 		enums.ColumnNameIndex:                  NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameHealth:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameReference:              NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameAddress:                NewDefaultColumnConfig(text.AlignLeft, text.AlignCenter, false),
 		enums.ColumnNamePortRPC:                NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameTotalTransactionBlocks: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameLatestCheckpoint:       NewDefaultColumnConfig(text.AlignLeft, text.AlignLeft, false),
 		enums.ColumnNameCurrentEpoch:           NewDefaultColumnConfig(text.AlignLeft, text.AlignLeft, false),
+		enums.ColumnNameFailedAttempts:         NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameReason:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignLeft, false),
 	}
 	RowsConfigRPC = RowsConfig{
 		0: {
 			enums.ColumnNameIndex,
 			enums.ColumnNameHealth,
+			enums.ColumnNameReference,
 			enums.ColumnNameAddress,
 			enums.ColumnNamePortRPC,
 			enums.ColumnNameTotalTransactionBlocks,
 			enums.ColumnNameLatestCheckpoint,
 			enums.ColumnNameCurrentEpoch,
+			enums.ColumnNameFailedAttempts,
+			enums.ColumnNameReason,
 		},
 	}
 )
@@ -34,7 +40,7 @@ var (
 // The function retrieves information about the RPC service from the host's internal state and formats it into a map of NodeColumnName keys and corresponding values.
 // Returns a map of NodeColumnName keys to corresponding values.
 func GetRPCColumnValues(idx int, host host.Host) ColumnValues {
-	status := host.Status.StatusToPlaceholder()
+	status := host.Status
 	port := host.Ports[enums.PortTypeRPC]
 	if port == "" {
 		port = RpcPortDefault
@@ -44,10 +50,13 @@ func GetRPCColumnValues(idx int, host host.Host) ColumnValues {
 	return ColumnValues{
 		enums.ColumnNameIndex:                  idx + 1,
 		enums.ColumnNameHealth:                 status,
+		enums.ColumnNameReference:              host.Reference,
 		enums.ColumnNameAddress:                address,
 		enums.ColumnNamePortRPC:                port,
 		enums.ColumnNameTotalTransactionBlocks: host.Metrics.TotalTransactionsBlocks,
 		enums.ColumnNameLatestCheckpoint:       host.Metrics.LatestCheckpoint,
 		enums.ColumnNameCurrentEpoch:           host.Metrics.SystemState.Epoch,
+		enums.ColumnNameFailedAttempts:         host.FailedAttempts(),
+		enums.ColumnNameReason:                 host.FailedChecks,
 	}
 }
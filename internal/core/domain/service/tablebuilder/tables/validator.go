@@ -1,8 +1,6 @@
 package tables
 
 import (
-	"fmt"
-
 	"github.com/jedib0t/go-pretty/v6/text"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
@@ -37,6 +35,16 @@ var (
 		enums.ColumnNameSkippedConsensusTransactions:            NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameTotalSignatureErrors:                    NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 		enums.ColumnNameHandleCertificateNonConsensusLatencySum: NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameFailedAttempts:                          NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameReason:                                  NewDefaultColumnConfig(text.AlignCenter, text.AlignLeft, false),
+		enums.ColumnNameValidatorName:                           NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorVotingPower:                    NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorNextEpochStake:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorCommissionRate:                 NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorNextEpochGasPrice:              NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameValidatorApy:                            NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameSystemAtRiskValidatorNumberOfEpochs:     NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
+		enums.ColumnNameSystemValidatorSlashingPercentage:       NewDefaultColumnConfig(text.AlignCenter, text.AlignCenter, false),
 	}
 
 	RowsConfigValidator = RowsConfig{
@@ -66,6 +74,18 @@ var (
 			enums.ColumnNamePrimaryNetworkPeers,
 			enums.ColumnNameWorkerNetworkPeers,
 			enums.ColumnNameTotalSignatureErrors,
+			enums.ColumnNameFailedAttempts,
+			enums.ColumnNameReason,
+		},
+		2: {
+			enums.ColumnNameValidatorName,
+			enums.ColumnNameValidatorVotingPower,
+			enums.ColumnNameValidatorNextEpochStake,
+			enums.ColumnNameValidatorCommissionRate,
+			enums.ColumnNameValidatorNextEpochGasPrice,
+			enums.ColumnNameValidatorApy,
+			enums.ColumnNameSystemAtRiskValidatorNumberOfEpochs,
+			enums.ColumnNameSystemValidatorSlashingPercentage,
 		},
 	}
 )
@@ -75,7 +95,7 @@ var (
 // The function also includes emoji values in the map if the specified flag is true.
 // Returns a map of ValidatorColumnName keys to corresponding values.
 func GetValidatorColumnValues(idx int, host host.Host) ColumnValues {
-	status := host.Status.StatusToPlaceholder()
+	status := host.Status
 
 	var country string
 	if host.IPInfo != nil {
@@ -96,7 +116,7 @@ func GetValidatorColumnValues(idx int, host host.Host) ColumnValues {
 		enums.ColumnNameCheckpointExecBacklog:                   host.Metrics.CheckpointExecBacklog,
 		enums.ColumnNameCheckpointSyncBacklog:                   host.Metrics.CheckpointSyncBacklog,
 		enums.ColumnNameCurrentEpoch:                            host.Metrics.CurrentEpoch,
-		enums.ColumnNameCheckSyncPercentage:                     fmt.Sprintf("%v%%", host.Metrics.CheckSyncPercentage),
+		enums.ColumnNameCheckSyncPercentage:                     host.Metrics.CheckSyncPercentage,
 		enums.ColumnNameNetworkPeers:                            host.Metrics.NetworkPeers,
 		enums.ColumnNameUptime:                                  host.Metrics.Uptime,
 		enums.ColumnNameVersion:                                 host.Metrics.Version,
@@ -111,6 +131,12 @@ func GetValidatorColumnValues(idx int, host host.Host) ColumnValues {
 		enums.ColumnNameCertificatesCreated:                     host.Metrics.CertificatesCreated,
 		enums.ColumnNameHandleCertificateNonConsensusLatencySum: host.Metrics.NonConsensusLatency,
 		enums.ColumnNameCountry:                                 country,
+		enums.ColumnNameFailedAttempts:                          host.FailedAttempts(),
+		enums.ColumnNameReason:                                  host.FailedChecks,
+	}
+
+	for columnName, value := range host.OnChain.ColumnValues() {
+		columnValues[columnName] = value
 	}
 
 	return columnValues
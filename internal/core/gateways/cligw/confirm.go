@@ -0,0 +1,17 @@
+package cligw
+
+import "github.com/AlecAivazis/survey/v2"
+
+func (gateway *Gateway) Confirm(question string, defaultValue bool) (bool, error) {
+	result := new(bool)
+	prompt := &survey.Confirm{
+		Message: question,
+		Default: defaultValue,
+	}
+
+	if err := survey.AskOne(prompt, result, gateway.icons); err != nil {
+		return false, err
+	}
+
+	return *result, nil
+}
@@ -18,7 +18,7 @@ func (gateway *Gateway) Error(msg string) {
 }
 
 func (gateway *Gateway) Errorf(msg string, vars ...interface{}) {
-	gateway.ErrorfWithOpts(msg, MsgOpts{}, vars)
+	gateway.ErrorfWithOpts(msg, MsgOpts{}, vars...)
 }
 
 func (gateway *Gateway) ErrorfWithOpts(msg string, opts MsgOpts, vars ...interface{}) {
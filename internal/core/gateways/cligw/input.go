@@ -0,0 +1,38 @@
+package cligw
+
+import (
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+type InputOpts struct {
+	Default  string
+	Validate func(answer string) error
+}
+
+func (gateway *Gateway) Input(question string) (string, error) {
+	return gateway.InputWithOpts(question, InputOpts{})
+}
+
+func (gateway *Gateway) InputWithOpts(question string, opts InputOpts) (string, error) {
+	result := new(string)
+	prompt := &survey.Input{
+		Message: question,
+		Default: opts.Default,
+	}
+
+	askOpts := []survey.AskOpt{gateway.icons}
+
+	if opts.Validate != nil {
+		askOpts = append(askOpts, survey.WithValidator(func(answer interface{}) error {
+			return opts.Validate(strings.TrimSpace(answer.(string)))
+		}))
+	}
+
+	if err := survey.AskOne(prompt, result, askOpts...); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(*result), nil
+}
@@ -18,11 +18,11 @@ func (gateway *Gateway) Warn(msg string) {
 }
 
 func (gateway *Gateway) Warnf(msg string, vars ...interface{}) {
-	gateway.WarnfWithOpts(msg, MsgOpts{}, vars)
+	gateway.WarnfWithOpts(msg, MsgOpts{}, vars...)
 }
 
 func (gateway *Gateway) WarnfWithOpts(msg string, opts MsgOpts, vars ...interface{}) {
-	msg = fmt.Sprintf(msg, vars)
+	msg = fmt.Sprintf(msg, vars...)
 	gateway.WarnWithOpts(msg, opts)
 }
 
@@ -35,7 +35,7 @@ func (Gateway) WarnWithOpts(msg string, opts MsgOpts) {
 
 	formattedIcon := iconWarnColor.Sprint(icon)
 	formattedMsg := messageWarnColor.Sprint(msg)
-	
+
 	result := fmt.Sprintf("%s  %s", formattedIcon, formattedMsg)
 
 	fmt.Println(result)
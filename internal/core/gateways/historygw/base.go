@@ -0,0 +1,61 @@
+package historygw
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	databaseFile     = "history.db"
+	databaseFileMode = 0o600
+	databaseDirMode  = 0o700
+
+	// databaseLockTimeout is the time to wait for the database, which is locked while it is used by another suimon process.
+	databaseLockTimeout = 5 * time.Second
+)
+
+type Gateway struct {
+	path       string
+	retention  time.Duration
+	cliGateway *cligw.Gateway
+}
+
+// NewGateway creates the history store in the specified directory. The records older than the retention
+// are removed on every write, zero retention keeps the records forever.
+// The database is opened for every read and write only, so the store can be queried while another suimon process records to it.
+func NewGateway(cliGW *cligw.Gateway, dirPath string, retention time.Duration) (ports.HistoryGateway, error) {
+	if err := os.MkdirAll(dirPath, databaseDirMode); err != nil {
+		return nil, fmt.Errorf("failed to create history directory %s: %w", dirPath, err)
+	}
+
+	return &Gateway{
+		path:       filepath.Join(dirPath, databaseFile),
+		retention:  retention,
+		cliGateway: cliGW,
+	}, nil
+}
+
+// open opens the history database, the database is created if it does not exist and opened for writing.
+func (gateway *Gateway) open(readOnly bool) (*bolt.DB, error) {
+	db, err := bolt.Open(gateway.path, databaseFileMode, &bolt.Options{
+		Timeout:  databaseLockTimeout,
+		ReadOnly: readOnly,
+	})
+	if errors.Is(err, bolt.ErrTimeout) {
+		return nil, fmt.Errorf("history database %s is locked by another process", gateway.path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history database %s: %w", gateway.path, err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,109 @@
+package historygw
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// Query returns the records of the network matching the query, sorted by the table, host and time.
+// The host matches if it equals to the recorded host address with the port, with or without the scheme,
+// or to the address without the port, which matches all the hosts on the address.
+func (gateway *Gateway) Query(query ports.HistoryQuery) ([]ports.HistoryRecord, error) {
+	if _, err := os.Stat(gateway.path); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	db, err := gateway.open(true)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var records []ports.HistoryRecord
+
+	err = db.View(func(tx *bolt.Tx) error {
+		networkBucket := tx.Bucket([]byte(query.Network))
+		if networkBucket == nil {
+			return nil
+		}
+
+		sinceKey := timestampKey(query.Since)
+
+		return networkBucket.ForEach(func(name, _ []byte) error {
+			table, _, ok := strings.Cut(string(name), hostKeySeparator)
+			if !ok || (query.Table != "" && query.Table != table) {
+				return nil
+			}
+
+			hostBucket := networkBucket.Bucket(name)
+			if hostBucket == nil {
+				return nil
+			}
+
+			cursor := hostBucket.Cursor()
+
+			for key, value := cursor.Seek(sinceKey); key != nil; key, value = cursor.Next() {
+				var record ports.HistoryRecord
+
+				if err := json.Unmarshal(value, &record); err != nil {
+					return fmt.Errorf("failed to decode history record of table %s: %w", table, err)
+				}
+
+				if !hostMatches(record.Host, query.Host) {
+					continue
+				}
+
+				records = append(records, record)
+			}
+
+			return nil
+		})
+	})
+
+	return records, err
+}
+
+// hostMatches checks whether the recorded host address matches the queried one.
+func hostMatches(recorded, queried string) bool {
+	if queried == "" {
+		return true
+	}
+
+	recorded, queried = trimScheme(recorded), trimScheme(queried)
+	if strings.EqualFold(recorded, queried) {
+		return true
+	}
+
+	hostname, _, found := strings.Cut(recorded, "/")
+	if !found {
+		hostname = recorded
+	}
+
+	if strings.EqualFold(hostname, queried) {
+		return true
+	}
+
+	// the address without the port matches all the hosts on the address
+	if address, _, err := net.SplitHostPort(hostname); err == nil {
+		return strings.EqualFold(address, queried)
+	}
+
+	return false
+}
+
+// trimScheme removes the URL scheme from the address.
+func trimScheme(address string) string {
+	if _, rest, found := strings.Cut(address, "://"); found {
+		return rest
+	}
+
+	return address
+}
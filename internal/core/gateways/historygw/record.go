@@ -0,0 +1,91 @@
+package historygw
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// hostKeySeparator separates the table and the host key in the names of the hosts buckets.
+const hostKeySeparator = "|"
+
+// Record stores the records of the specified network and removes the network records older than the retention.
+// The records of every host are kept in a separate bucket keyed by the record timestamps, nested in the network bucket.
+// The buckets are named by the table and the key of the host, so the hosts sharing an address are kept apart.
+func (gateway *Gateway) Record(network string, records []ports.HistoryRecord) error {
+	db, err := gateway.open(false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(func(tx *bolt.Tx) error {
+		networkBucket, err := tx.CreateBucketIfNotExists([]byte(network))
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			hostBucket, err := networkBucket.CreateBucketIfNotExists(hostKey(record.Table, record.Key))
+			if err != nil {
+				return err
+			}
+
+			value, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+
+			if err := hostBucket.Put(timestampKey(record.Timestamp), value); err != nil {
+				return fmt.Errorf("failed to record history for host %s: %w", record.Host, err)
+			}
+		}
+
+		if gateway.retention <= 0 {
+			return nil
+		}
+
+		return prune(networkBucket, time.Now().Add(-gateway.retention))
+	})
+}
+
+// prune removes the records older than the provided time from all hosts buckets of the network.
+func prune(networkBucket *bolt.Bucket, before time.Time) error {
+	beforeKey := timestampKey(before)
+
+	return networkBucket.ForEach(func(name, _ []byte) error {
+		hostBucket := networkBucket.Bucket(name)
+		if hostBucket == nil {
+			return nil
+		}
+
+		cursor := hostBucket.Cursor()
+
+		for key, _ := cursor.First(); key != nil && bytes.Compare(key, beforeKey) < 0; key, _ = cursor.Next() {
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// hostKey returns the name of the bucket holding the records of the host.
+func hostKey(table, key string) []byte {
+	return []byte(table + hostKeySeparator + key)
+}
+
+// timestampKey encodes the timestamp as a big-endian key, so the records are sorted by time.
+func timestampKey(timestamp time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(timestamp.UnixNano()))
+
+	return key
+}
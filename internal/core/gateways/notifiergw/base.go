@@ -0,0 +1,76 @@
+package notifiergw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	httpClientTimeout = 5 * time.Second
+
+	telegramAPIURL = "https://api.telegram.org/bot%s/sendMessage"
+)
+
+type Gateway struct {
+	ctx          context.Context
+	notifierType enums.NotifierType
+	url          string
+	chatID       string
+	template     *template.Template
+	client       *http.Client
+	cliGateway   *cligw.Gateway
+}
+
+// NewGateway creates the notifier of the type specified in the configuration.
+// It returns an error if the notifier type is not supported, the required settings are missing
+// or an environment variable referenced by the settings is not set.
+func NewGateway(cliGW *cligw.Gateway, notifierConfig config.Notifier) (ports.NotifierGateway, error) {
+	notifierType, ok := enums.NotifierTypeFromString(notifierConfig.Type)
+	if !ok {
+		return nil, fmt.Errorf("unsupported notifier type %q, use one of: %v", notifierConfig.Type, enums.NotifierTypes())
+	}
+
+	notifierConfig, err := notifierConfig.Expand()
+	if err != nil {
+		return nil, fmt.Errorf("%s notifier: %w", notifierType, err)
+	}
+
+	url := notifierConfig.URL
+
+	switch notifierType {
+	case enums.NotifierTypeTelegram:
+		if notifierConfig.Token == "" || notifierConfig.ChatID == "" {
+			return nil, errors.New("telegram notifier requires token and chat-id")
+		}
+
+		url = fmt.Sprintf(telegramAPIURL, notifierConfig.Token)
+	default:
+		if url == "" {
+			return nil, fmt.Errorf("%s notifier requires url", notifierType)
+		}
+	}
+
+	messageTemplate, err := newTemplate(notifierConfig.Template)
+	if err != nil {
+		return nil, fmt.Errorf("%s notifier: %w", notifierType, err)
+	}
+
+	return &Gateway{
+		ctx:          context.Background(),
+		notifierType: notifierType,
+		url:          url,
+		chatID:       notifierConfig.ChatID,
+		template:     messageTemplate,
+		client:       &http.Client{Timeout: httpClientTimeout},
+		cliGateway:   cliGW,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package notifiergw
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// maxErrorBodySize limits the part of the error response body included in the returned error.
+const maxErrorBodySize = 512
+
+// Notify renders the message for the notification and delivers it to the notifier.
+// The webhook notifier receives the whole notification as JSON, the other notifiers receive the rendered message only.
+func (gateway *Gateway) Notify(notification ports.Notification) error {
+	message, err := gateway.renderMessage(notification)
+	if err != nil {
+		return err
+	}
+
+	notification.Message = message
+
+	var payload any
+
+	switch gateway.notifierType {
+	case enums.NotifierTypeSlack:
+		payload = map[string]string{"text": message}
+	case enums.NotifierTypeDiscord:
+		payload = map[string]string{"content": message}
+	case enums.NotifierTypeTelegram:
+		payload = map[string]string{"chat_id": gateway.chatID, "text": message}
+	default:
+		payload = notification
+	}
+
+	if err := gateway.post(payload); err != nil {
+		return fmt.Errorf("failed to deliver %s notification: %w", gateway.notifierType, err)
+	}
+
+	return nil
+}
+
+// post sends the payload as JSON to the notifier URL and checks the response status.
+func (gateway *Gateway) post(payload any) error {
+	var body bytes.Buffer
+
+	encoder := json.NewEncoder(&body)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(payload); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(gateway.ctx, httpClientTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, gateway.url, &body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	response, err := gateway.client.Do(req)
+	if err != nil {
+		// the notifier URLs hold the secret tokens, so they are stripped from the error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("%s request failed: %w", urlErr.Op, urlErr.Err)
+		}
+
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		responseBody, _ := io.ReadAll(io.LimitReader(response.Body, maxErrorBodySize))
+
+		return fmt.Errorf("unexpected response status %s: %s", response.Status, bytes.TrimSpace(responseBody))
+	}
+
+	return nil
+}
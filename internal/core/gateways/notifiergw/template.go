@@ -0,0 +1,39 @@
+package notifiergw
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// defaultTemplate is used to render the messages when the notifier does not specify its own template.
+const defaultTemplate = `{{ if eq .State "FIRING" }}🔴{{ else if eq .State "INFO" }}🔵{{ else }}🟢{{ end }} [{{ .State }}] {{ .Rule }}: {{ .Condition }}
+network: {{ .Network }}, {{ .Table }}: {{ .Host }}
+{{ .Metric }}: {{ .Value }}{{ if .Reference }}, RPC reference: {{ .Reference }}{{ end }}`
+
+// newTemplate parses the message template, the default template is used if the provided one is empty.
+func newTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		text = defaultTemplate
+	}
+
+	messageTemplate, err := template.New("message").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message template: %w", err)
+	}
+
+	return messageTemplate, nil
+}
+
+// renderMessage renders the message for the notification with the notifier template.
+func (gateway *Gateway) renderMessage(notification ports.Notification) (string, error) {
+	var message strings.Builder
+
+	if err := gateway.template.Execute(&message, notification); err != nil {
+		return "", fmt.Errorf("failed to render message template: %w", err)
+	}
+
+	return message.String(), nil
+}
@@ -2,31 +2,43 @@ package prometheusgw
 
 import (
 	"context"
+	"fmt"
 	"net/http"
-	"time"
+	"sync/atomic"
 
+	"github.com/bartosian/suimon/internal/core/domain/config"
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
 	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/httpclient"
 )
 
-const httpClientTimeout = 3 * time.Second
-
 type Gateway struct {
-	ctx        context.Context
-	url        string
-	client     *http.Client
-	cliGateway *cligw.Gateway
+	ctx            context.Context
+	url            string
+	client         *http.Client
+	connection     config.Connection
+	failedAttempts atomic.Int64
+	cliGateway     *cligw.Gateway
 }
 
-func NewGateway(cliGW *cligw.Gateway, url string) ports.PrometheusGateway {
-	httpClient := http.Client{
-		Timeout: httpClientTimeout,
+// NewGateway creates the metrics gateway for the URL. The timeout, the authentication and the TLS settings of the requests
+// and the retries of the failed ones are taken from the connection settings, the defaults are used for the settings not set.
+func NewGateway(cliGW *cligw.Gateway, url string, connection config.Connection) (ports.PrometheusGateway, error) {
+	clientOptions, err := connection.ClientOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid connection settings for %s: %w", url, err)
 	}
 
 	return &Gateway{
 		ctx:        context.Background(),
 		url:        url,
-		client:     &httpClient,
+		client:     httpclient.New(clientOptions),
+		connection: connection,
 		cliGateway: cliGW,
-	}
+	}, nil
+}
+
+// FailedAttempts returns the number of the failed requests to the metrics endpoint, including the retried ones.
+func (gateway *Gateway) FailedAttempts() int {
+	return int(gateway.failedAttempts.Load())
 }
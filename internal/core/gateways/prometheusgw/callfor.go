@@ -2,6 +2,7 @@ package prometheusgw
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
 	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/retry"
 )
 
 type (
@@ -20,26 +22,68 @@ type (
 		response *http.Response
 		err      error
 	}
+
+	// statusError is returned if the metrics endpoint responds with an error status code.
+	statusError struct {
+		code int
+	}
 )
 
 // CallFor makes an HTTP request to the specified gateway URL to fetch metrics.
+// The request is retried with a jittered exponential backoff if it fails with a transient error,
+// the failed attempts are added to the counter of the gateway.
 // It returns the metrics result or an error if something goes wrong.
 func (gateway *Gateway) CallFor(metrics ports.Metrics) (result ports.MetricsResult, err error) {
 	if len(metrics) == 0 {
 		return nil, fmt.Errorf("no metrics provided")
 	}
 
+	policy := retry.Policy{
+		Retries: gateway.connection.GetRetries(),
+		Backoff: gateway.connection.GetBackoff(),
+	}
+
+	var data MetricsData
+
+	failed, err := retry.Do(gateway.ctx, policy, isTransient, func() (err error) {
+		data, err = gateway.call()
+
+		return err
+	})
+
+	gateway.failedAttempts.Add(int64(failed))
+
+	if err != nil {
+		return nil, err
+	}
+
+	metricsResult := make(ports.MetricsResult)
+
+	for metricName, metricConfig := range metrics {
+		result, err := getMetricValueWithLabelFiltering(data, metricName.ToString(), metricConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		metricsResult[metricName] = result
+	}
+
+	return metricsResult, nil
+}
+
+// call makes a single HTTP request to the gateway URL and parses the metrics from the response.
+func (gateway *Gateway) call() (data MetricsData, err error) {
 	req, err := http.NewRequest("GET", gateway.url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(gateway.ctx, httpClientTimeout)
+	ctx, cancel := context.WithTimeout(gateway.ctx, gateway.connection.GetTimeout())
 	defer cancel()
 
 	req = req.WithContext(ctx)
 
-	respChan := make(chan responseWithError)
+	respChan := make(chan responseWithError, 1)
 
 	go func() {
 		resp, err := gateway.client.Do(req)
@@ -57,30 +101,33 @@ func (gateway *Gateway) CallFor(metrics ports.Metrics) (result ports.MetricsResu
 
 		response := result.response
 		defer func() {
-			if closeErr := response.Body.Close(); closeErr != nil {
+			if closeErr := response.Body.Close(); closeErr != nil && err == nil {
 				err = fmt.Errorf("failed to close response body: %w", closeErr)
 			}
 		}()
 
-		parser := expfmt.TextParser{}
-		data, err := parser.TextToMetricFamilies(response.Body)
-		if err != nil {
-			return nil, err
+		if response.StatusCode >= http.StatusBadRequest {
+			return nil, &statusError{code: response.StatusCode}
 		}
 
-		metricsResult := make(ports.MetricsResult)
+		parser := expfmt.TextParser{}
 
-		for metricName, metricConfig := range metrics {
-			result, err := getMetricValueWithLabelFiltering(data, metricName.ToString(), metricConfig)
-			if err != nil {
-				return nil, err
-			}
+		return parser.TextToMetricFamilies(response.Body)
+	}
+}
 
-			metricsResult[metricName] = result
-		}
+func (err *statusError) Error() string {
+	return fmt.Sprintf("http call failed with status code: %d", err.code)
+}
 
-		return metricsResult, nil
+// isTransient reports whether the HTTP request failed with a network error or a server error worth retrying on.
+func isTransient(err error) bool {
+	var statusErr *statusError
+	if errors.As(err, &statusErr) && statusErr.code >= http.StatusInternalServerError {
+		return true
 	}
+
+	return retry.IsTransient(err)
 }
 
 // getMetricValueWithLabelFiltering searches for a specific metric in the provided MetricsData
@@ -2,31 +2,37 @@ package rpcgw
 
 import (
 	"context"
-	"net/http"
-	"time"
+	"fmt"
+	"sync/atomic"
 
 	"github.com/ybbus/jsonrpc/v3"
 
+	"github.com/bartosian/suimon/internal/core/domain/config"
 	"github.com/bartosian/suimon/internal/core/gateways/cligw"
 	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/httpclient"
 )
 
-const rpcClientTimeout = 3 * time.Second
-
 type Gateway struct {
-	ctx        context.Context
-	url        string
-	client     jsonrpc.RPCClient
-	cliGateway *cligw.Gateway
+	ctx              context.Context
+	url              string
+	client           jsonrpc.RPCClient
+	connection       config.Connection
+	failedAttempts   atomic.Int64
+	batchUnsupported atomic.Bool
+	cliGateway       *cligw.Gateway
 }
 
-func NewGateway(cliGW *cligw.Gateway, url string) ports.RPCGateway {
-	httpClient := &http.Client{
-		Timeout: rpcClientTimeout,
+// NewGateway creates the RPC gateway for the URL. The timeout, the authentication and the TLS settings of the requests
+// and the retries of the failed ones are taken from the connection settings, the defaults are used for the settings not set.
+func NewGateway(cliGW *cligw.Gateway, url string, connection config.Connection) (ports.RPCGateway, error) {
+	clientOptions, err := connection.ClientOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid connection settings for %s: %w", url, err)
 	}
 
 	opts := &jsonrpc.RPCClientOpts{
-		HTTPClient: httpClient,
+		HTTPClient: httpclient.New(clientOptions),
 	}
 
 	rpcClient := jsonrpc.NewClientWithOpts(url, opts)
@@ -35,6 +41,12 @@ func NewGateway(cliGW *cligw.Gateway, url string) ports.RPCGateway {
 		ctx:        context.Background(),
 		url:        url,
 		client:     rpcClient,
+		connection: connection,
 		cliGateway: cliGW,
-	}
+	}, nil
+}
+
+// FailedAttempts returns the number of the failed requests to the RPC, including the retried ones.
+func (gateway *Gateway) FailedAttempts() int {
+	return int(gateway.failedAttempts.Load())
 }
@@ -0,0 +1,124 @@
+package rpcgw
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ybbus/jsonrpc/v3"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+	"github.com/bartosian/suimon/internal/pkg/retry"
+)
+
+// BatchCallFor executes the RPC methods in a single JSON-RPC batch request and returns the responses in the order
+// of the requests, with the error of every method kept in its response. The batch request is retried the same way
+// the single calls are. If the endpoint rejects the batch request, the methods are called one by one and, once the
+// single calls succeed, the batching is skipped for the endpoint from then on.
+func (gateway *Gateway) BatchCallFor(requests ...ports.RPCRequest) (responses []ports.RPCResponse, err error) {
+	if len(requests) == 1 || gateway.batchUnsupported.Load() {
+		return gateway.callEach(requests), nil
+	}
+
+	policy := retry.Policy{
+		Retries: gateway.connection.GetRetries(),
+		Backoff: gateway.connection.GetBackoff(),
+	}
+
+	failed, err := retry.Do(gateway.ctx, policy, isTransient, func() (err error) {
+		responses, err = gateway.callBatch(requests)
+
+		return err
+	})
+
+	gateway.failedAttempts.Add(int64(failed))
+
+	switch {
+	case err == nil:
+		return responses, nil
+	case isTransient(err):
+		return nil, err
+	}
+
+	responses = gateway.callEach(requests)
+
+	for _, response := range responses {
+		if response.Err == nil {
+			gateway.batchUnsupported.Store(true)
+
+			break
+		}
+	}
+
+	return responses, nil
+}
+
+// callBatch sends a single batch request with all RPC methods and maps the responses back to the requests by their IDs.
+func (gateway *Gateway) callBatch(requests []ports.RPCRequest) ([]ports.RPCResponse, error) {
+	ctx, cancel := context.WithTimeout(gateway.ctx, gateway.connection.GetTimeout())
+	defer cancel()
+
+	batch := make(jsonrpc.RPCRequests, 0, len(requests))
+
+	for _, request := range requests {
+		batch = append(batch, jsonrpc.NewRequest(request.Method.String(), request.Params))
+	}
+
+	rpcResponses, err := gateway.client.CallBatch(ctx, batch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batch response from RPC client: %w", err)
+	}
+
+	rpcResponsesByID := rpcResponses.AsMap()
+	responses := make([]ports.RPCResponse, len(requests))
+
+	for idx, request := range requests {
+		rpcResponse, ok := rpcResponsesByID[idx]
+
+		switch {
+		case !ok || rpcResponse == nil:
+			responses[idx].Err = fmt.Errorf("no response for %s in batch response from RPC client", request.Method)
+		case rpcResponse.Error != nil:
+			responses[idx].Err = fmt.Errorf("failed to get response from RPC client for %s: %w", request.Method, rpcResponse.Error)
+		default:
+			var result any
+
+			if err := rpcResponse.GetObject(&result); err != nil {
+				responses[idx].Err = fmt.Errorf("failed to get response from RPC client for %s: %w", request.Method, err)
+
+				continue
+			}
+
+			if result == nil {
+				responses[idx].Err = fmt.Errorf("empty response from RPC client for %s", request.Method)
+
+				continue
+			}
+
+			responses[idx].Result = result
+		}
+	}
+
+	return responses, nil
+}
+
+// callEach calls the RPC methods concurrently one by one and returns the responses in the order of the requests.
+func (gateway *Gateway) callEach(requests []ports.RPCRequest) []ports.RPCResponse {
+	responses := make([]ports.RPCResponse, len(requests))
+
+	var wg sync.WaitGroup
+
+	for idx, request := range requests {
+		wg.Add(1)
+
+		go func(idx int, request ports.RPCRequest) {
+			defer wg.Done()
+
+			responses[idx].Result, responses[idx].Err = gateway.CallFor(request.Method, request.Params...)
+		}(idx, request)
+	}
+
+	wg.Wait()
+
+	return responses
+}
@@ -2,9 +2,14 @@ package rpcgw
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+
+	"github.com/ybbus/jsonrpc/v3"
 
 	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/pkg/retry"
 )
 
 type responseWithError struct {
@@ -13,11 +18,34 @@ type responseWithError struct {
 }
 
 // CallFor executes an RPC method and returns the result or an error.
-// The function sends an RPC request using the specified method and params, waits for the response, and handles timeouts.
+// The call is retried with a jittered exponential backoff if it fails with a transient error,
+// the failed attempts are added to the counter of the gateway.
 func (gateway *Gateway) CallFor(method enums.RPCMethod, params ...interface{}) (result any, err error) {
-	respChan := make(chan responseWithError)
+	policy := retry.Policy{
+		Retries: gateway.connection.GetRetries(),
+		Backoff: gateway.connection.GetBackoff(),
+	}
+
+	failed, err := retry.Do(gateway.ctx, policy, isTransient, func() (err error) {
+		result, err = gateway.call(method, params...)
+
+		return err
+	})
+
+	gateway.failedAttempts.Add(int64(failed))
+
+	if err != nil {
+		return nil, err
+	}
 
-	ctx, cancel := context.WithTimeout(gateway.ctx, rpcClientTimeout)
+	return result, nil
+}
+
+// call sends a single RPC request using the specified method and params, waits for the response, and handles timeouts.
+func (gateway *Gateway) call(method enums.RPCMethod, params ...interface{}) (result any, err error) {
+	respChan := make(chan responseWithError, 1)
+
+	ctx, cancel := context.WithTimeout(gateway.ctx, gateway.connection.GetTimeout())
 	defer cancel()
 
 	go func() {
@@ -43,3 +71,13 @@ func (gateway *Gateway) CallFor(method enums.RPCMethod, params ...interface{}) (
 		return result.response, nil
 	}
 }
+
+// isTransient reports whether the RPC call failed with a network error or a server error worth retrying on.
+func isTransient(err error) bool {
+	var httpErr *jsonrpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.Code >= http.StatusInternalServerError {
+		return true
+	}
+
+	return retry.IsTransient(err)
+}
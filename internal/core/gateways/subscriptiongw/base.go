@@ -0,0 +1,73 @@
+package subscriptiongw
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/bartosian/suimon/internal/core/domain/config"
+	"github.com/bartosian/suimon/internal/core/gateways/cligw"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type Gateway struct {
+	url        string
+	config     websocket.Config
+	connection config.Connection
+	cliGateway *cligw.Gateway
+}
+
+// NewGateway creates the gateway subscribing to the notifications of the RPC over WebSocket. The WebSocket URL
+// is derived from the RPC URL, the timeout, the authentication and the TLS settings are taken from the connection settings.
+func NewGateway(cliGW *cligw.Gateway, rpcURL string, connection config.Connection) (ports.SubscriptionGateway, error) {
+	clientOptions, err := connection.ClientOptions()
+	if err != nil {
+		return nil, fmt.Errorf("invalid connection settings for %s: %w", rpcURL, err)
+	}
+
+	wsURL, err := websocketURL(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+
+	wsConfig, err := websocket.NewConfig(wsURL, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid websocket address %s: %w", wsURL, err)
+	}
+
+	wsConfig.Header = clientOptions.Headers
+	wsConfig.TlsConfig = clientOptions.TLS
+	wsConfig.Dialer = &net.Dialer{Timeout: clientOptions.Timeout}
+
+	return &Gateway{
+		url:        wsURL,
+		config:     *wsConfig,
+		connection: connection,
+		cliGateway: cliGW,
+	}, nil
+}
+
+// websocketURL returns the WebSocket URL served on the same address as the RPC URL.
+func websocketURL(rpcURL string) (string, error) {
+	wsURL, err := url.Parse(rpcURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid rpc address %s: %w", rpcURL, err)
+	}
+
+	switch wsURL.Scheme {
+	case "http":
+		wsURL.Scheme = "ws"
+	case "https":
+		wsURL.Scheme = "wss"
+	default:
+		return "", fmt.Errorf("unsupported rpc address scheme %q of %s", wsURL.Scheme, rpcURL)
+	}
+
+	if wsURL.Path == "" {
+		wsURL.Path = "/"
+	}
+
+	return wsURL.String(), nil
+}
@@ -0,0 +1,180 @@
+package subscriptiongw
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+)
+
+const (
+	jsonRPCVersion = "2.0"
+	subscribeID    = 1
+)
+
+type (
+	// request holds the JSON-RPC request sent to subscribe to the notifications.
+	request struct {
+		JSONRPC string `json:"jsonrpc"`
+		ID      int    `json:"id"`
+		Method  string `json:"method"`
+		Params  []any  `json:"params"`
+	}
+
+	// message holds either the response to the subscription request or a notification of the subscription.
+	message struct {
+		ID     *int                `json:"id,omitempty"`
+		Result json.RawMessage     `json:"result,omitempty"`
+		Error  *messageError       `json:"error,omitempty"`
+		Params *notificationParams `json:"params,omitempty"`
+	}
+
+	messageError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	notificationParams struct {
+		Subscription json.RawMessage `json:"subscription"`
+		Result       json.RawMessage `json:"result"`
+	}
+)
+
+// Subscribe opens the WebSocket connection, subscribes to the notifications of the method and passes the result
+// of every notification received to the notify function. It blocks until the context is done, in which case nil
+// is returned, or until the subscription is rejected or the connection is dropped, in which case the error is returned.
+func (gateway *Gateway) Subscribe(ctx context.Context, method enums.RPCMethod, params []any, notify func(notification json.RawMessage)) error {
+	conn, err := gateway.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", gateway.url, err)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+
+		conn.Close()
+	}()
+
+	if err := gateway.subscribe(conn, method, params); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return fmt.Errorf("failed to subscribe to %s on %s: %w", method, gateway.url, err)
+	}
+
+	for {
+		var notification message
+
+		if err := websocket.JSON.Receive(conn, &notification); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("subscription to %s on %s dropped: %w", method, gateway.url, err)
+		}
+
+		if notification.Params != nil && len(notification.Params.Result) > 0 {
+			notify(notification.Params.Result)
+		}
+	}
+}
+
+// dial opens the WebSocket connection. The connection and the handshake are bounded by the timeout of the connection settings.
+func (gateway *Gateway) dial(ctx context.Context) (*websocket.Conn, error) {
+	config := gateway.config
+	location := config.Location
+
+	addr := location.Host
+	if location.Port() == "" {
+		port := "80"
+		if location.Scheme == "wss" {
+			port = "443"
+		}
+
+		addr = net.JoinHostPort(location.Hostname(), port)
+	}
+
+	timeout := gateway.connection.GetTimeout()
+
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		rawConn net.Conn
+		err     error
+	)
+
+	if location.Scheme == "wss" {
+		dialer := &tls.Dialer{Config: config.TlsConfig}
+		rawConn, err = dialer.DialContext(dialCtx, "tcp", addr)
+	} else {
+		dialer := &net.Dialer{}
+		rawConn, err = dialer.DialContext(dialCtx, "tcp", addr)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rawConn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		rawConn.Close()
+
+		return nil, err
+	}
+
+	conn, err := websocket.NewClient(&config, rawConn)
+	if err != nil {
+		rawConn.Close()
+
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// subscribe sends the subscription request and waits for the response within the timeout of the connection settings.
+func (gateway *Gateway) subscribe(conn *websocket.Conn, method enums.RPCMethod, params []any) error {
+	if err := conn.SetDeadline(time.Now().Add(gateway.connection.GetTimeout())); err != nil {
+		return err
+	}
+
+	if err := websocket.JSON.Send(conn, request{
+		JSONRPC: jsonRPCVersion,
+		ID:      subscribeID,
+		Method:  method.String(),
+		Params:  params,
+	}); err != nil {
+		return err
+	}
+
+	for {
+		var response message
+
+		if err := websocket.JSON.Receive(conn, &response); err != nil {
+			return err
+		}
+
+		if response.ID == nil || *response.ID != subscribeID {
+			continue
+		}
+
+		if response.Error != nil {
+			return fmt.Errorf("rpc error %d: %s", response.Error.Code, response.Error.Message)
+		}
+
+		return conn.SetDeadline(time.Time{})
+	}
+}
@@ -0,0 +1,42 @@
+package cmdhandlers
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type ConfigHandler struct {
+	command *cobra.Command
+}
+
+func NewConfigHandler() *ConfigHandler {
+	handler := &ConfigHandler{}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ConfigHandler) Start() {
+	_ = h.command.Execute()
+}
+
+func (h *ConfigHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ConfigHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ConfigHandler) newCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "config",
+		Aliases: []string{"c"},
+		Short:   "Manage the suimon configuration files.",
+		Long:    "The suimon config subcommand groups the commands managing the suimon configuration files stored in the directory set by the SUIMON_CONFIG_PATH environment variable or in the ~/.suimon directory.",
+	}
+}
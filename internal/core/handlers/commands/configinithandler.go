@@ -0,0 +1,63 @@
+package cmdhandlers
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type ConfigInitHandler struct {
+	command    *cobra.Command
+	controller ports.ConfigController
+	options    ports.ConfigInitOptions
+}
+
+func NewConfigInitHandler(
+	controller ports.ConfigController,
+) *ConfigInitHandler {
+	handler := &ConfigInitHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ConfigInitHandler) Start() {
+	_ = h.command.Execute()
+}
+
+func (h *ConfigInitHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ConfigInitHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ConfigInitHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "init",
+		Aliases: []string{"i"},
+		Short:   "Create the network configuration file interactively.",
+		Long:    "The suimon config init subcommand runs the interactive wizard creating the configuration file of the selected network. The public RPC defaults are suggested from the bundled templates, the full nodes and validators to monitor can be added, and every endpoint provided is checked for connectivity. The file is written to the suimon-<network>.yaml file in the configuration directory and linted.",
+		Example: "  suimon config init\n  suimon config init --network testnet",
+		Run:     h.handleCommand,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&h.options.Network, "network", "n", "", "network to create the configuration for: mainnet, testnet, devnet")
+
+	return cmd
+}
+
+func (h *ConfigInitHandler) handleCommand(_ *cobra.Command, _ []string) {
+	if err := h.controller.Init(h.options); err != nil {
+		fmt.Printf("Failed to run! %s\n", err)
+	}
+}
@@ -0,0 +1,64 @@
+package cmdhandlers
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type ConfigLintHandler struct {
+	command    *cobra.Command
+	controller ports.ConfigController
+	options    ports.ConfigLintOptions
+}
+
+func NewConfigLintHandler(
+	controller ports.ConfigController,
+) *ConfigLintHandler {
+	handler := &ConfigLintHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ConfigLintHandler) Start() {
+	_ = h.command.Execute()
+}
+
+func (h *ConfigLintHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ConfigLintHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ConfigLintHandler) newCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "lint [file...]",
+		Aliases: []string{"l"},
+		Short:   "Check the configuration files for problems.",
+		Long:    "The suimon config lint subcommand checks the configuration files and reports all problems found in them with the file name and the line number: unknown fields, invalid and duplicate addresses, invalid and duplicate alert rules, invalid notifiers and dashboards layouts. All configuration files in the configuration directory are checked if no files are provided. The command exits with a non-zero code if any problems are found.",
+		Example: "  suimon config lint\n  suimon config lint ~/.suimon/suimon-mainnet.yaml",
+		Run:     h.handleCommand,
+	}
+}
+
+func (h *ConfigLintHandler) handleCommand(_ *cobra.Command, args []string) {
+	h.options.Files = args
+
+	if err := h.controller.Lint(h.options); err != nil {
+		fmt.Printf("Failed to run! %s\n", err)
+
+		// the non-zero exit code lets the scripts and the CI pipelines fail on the problems found
+		os.Exit(1)
+	}
+}
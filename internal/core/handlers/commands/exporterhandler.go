@@ -0,0 +1,71 @@
+package cmdhandlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const (
+	exporterListenDefault   = ":9400"
+	exporterIntervalDefault = 30 * time.Second
+)
+
+type ExporterHandler struct {
+	command    *cobra.Command
+	controller ports.ExporterController
+	options    ports.ExporterOptions
+}
+
+func NewExporterHandler(
+	controller ports.ExporterController,
+) *ExporterHandler {
+	handler := &ExporterHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ExporterHandler) Start() {
+	_ = h.command.Execute()
+}
+
+func (h *ExporterHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ExporterHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ExporterHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "exporter",
+		Aliases: []string{"e"},
+		Short:   "Serve the health and derived metrics computed by suimon as Prometheus metrics.",
+		Long:    "The suimon exporter subcommand polls the hosts from the selected configuration on an interval and serves the metrics computed by suimon, such as the host health status, sync percentages, checkpoint backlogs, transactions and checkpoints per second and the reference gas price statistics, as Prometheus gauges labeled by network, table type and host address. Use this command to scrape suimon from your existing Prometheus and alert on its findings in Grafana.",
+		Example: "  suimon exporter --network mainnet --listen :9400 --interval 30s",
+		Run:     h.handleCommand,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&h.options.Network, "network", "n", "", "network configuration to use, e.g. testnet for the suimon-testnet.yaml file")
+	flags.StringVarP(&h.options.Listen, "listen", "l", exporterListenDefault, "address to serve the metrics on")
+	flags.DurationVarP(&h.options.Interval, "interval", "i", exporterIntervalDefault, "interval between the hosts polls")
+
+	return cmd
+}
+
+func (h *ExporterHandler) handleCommand(_ *cobra.Command, _ []string) {
+	if err := h.controller.Export(h.options); err != nil {
+		fmt.Printf("Failed to run! %s\n", err)
+	}
+}
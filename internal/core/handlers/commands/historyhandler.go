@@ -0,0 +1,72 @@
+package cmdhandlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const historySinceDefault = 24 * time.Hour
+
+type HistoryHandler struct {
+	command    *cobra.Command
+	controller ports.HistoryController
+	options    ports.HistoryOptions
+}
+
+func NewHistoryHandler(
+	controller ports.HistoryController,
+) *HistoryHandler {
+	handler := &HistoryHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *HistoryHandler) Start() {
+	_ = h.command.Execute()
+}
+
+func (h *HistoryHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *HistoryHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *HistoryHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Aliases: []string{"hs"},
+		Short:   "Print the metrics history recorded while the hosts were polled.",
+		Long:    "The suimon history subcommand prints the health status and the metric values of the hosts recorded by the watch and exporter commands on every poll. The records are kept in the local store under the data directory next to the configuration files for the retention period set in the history section of the configuration. The history can be filtered by the table, host and period and exported in the structured formats.",
+		Example: "  suimon history --network mainnet --host 10.0.0.1 --metric checkpoint-sync-backlog --since 24h\n  suimon history --network mainnet --table validator --since 1h --output csv",
+		Run:     h.handleCommand,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&h.options.Network, "network", "n", "", "network configuration to use, e.g. testnet for the suimon-testnet.yaml file")
+	flags.StringVarP(&h.options.Table, "table", "t", "", "table to print the history for: rpc, node, validator")
+	flags.StringVar(&h.options.Host, "host", "", "address of the host to print the history for, with the port to tell apart the hosts sharing the address")
+	flags.StringVarP(&h.options.Metric, "metric", "m", "", "metric to print along with the health status, e.g. checkpoint-sync-backlog")
+	flags.DurationVarP(&h.options.Since, "since", "s", historySinceDefault, "period to print the history for")
+	flags.StringVarP(&h.options.Output, "output", "o", enums.OutputFormatTable.ToString(), "output format: table, json, csv, markdown, html")
+
+	return cmd
+}
+
+func (h *HistoryHandler) handleCommand(_ *cobra.Command, _ []string) {
+	if err := h.controller.History(h.options); err != nil {
+		fmt.Printf("Failed to run! %s\n", err)
+	}
+}
@@ -2,14 +2,19 @@ package cmdhandlers
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+
 	"github.com/bartosian/suimon/internal/core/ports"
 )
 
 type MonitorHandler struct {
 	command    *cobra.Command
 	controller ports.MonitorController
+	options    ports.MonitorOptions
 }
 
 func NewMonitorHandler(
@@ -43,15 +48,33 @@ func (h *MonitorHandler) newCommand() *cobra.Command {
 		Use:     "monitor",
 		Aliases: []string{"m"},
 		Short:   "Monitor the running network with the suimon monitoring tool.",
-		Long:    "The suimon monitor subcommand allows you to monitor the running network with the suimon monitoring tool. This command provides options to render both static and dynamic dashboards. Static dashboards display various statistics related to the running network, such as the number of validators, peers, and gas prices. Dynamic dashboards provide real-time information about the network, such as block times and transaction throughput. You can select which dashboards to render using the command line interface. Use this command to keep an eye on the health and performance of your running network.",
+		Long:    "The suimon monitor subcommand allows you to monitor the running network with the suimon monitoring tool. This command provides options to render both static and dynamic dashboards. Static dashboards display various statistics related to the running network, such as the number of validators, peers, and gas prices. Dynamic dashboards provide real-time information about the network, such as block times and transaction throughput. You can select which dashboards to render using the command line interface, a running dynamic dashboard is switched to the other dashboards and hosts from the keyboard, press H for the shortcuts. Use this command to keep an eye on the health and performance of your running network.",
+		Example: "  suimon monitor --network testnet --static --tables rpc,node\n  suimon monitor --network testnet --tables all --output json --out-file testnet.json\n  suimon monitor --network mainnet --dynamic node --host 10.0.0.1:9000\n  suimon monitor --network mainnet --dynamic rpc --subscribe\n  suimon monitor --network mainnet --dynamic fleet",
 		Run:     h.handleCommand,
 	}
 
+	tableNames := strings.Join(enums.TableTypeAliases(), ", ")
+
+	flags := cmd.Flags()
+	flags.StringVarP(&h.options.Network, "network", "n", "", "network configuration to use, e.g. testnet for the suimon-testnet.yaml file")
+	flags.BoolVarP(&h.options.Static, "static", "s", false, "render static tables")
+	flags.StringSliceVarP(&h.options.Tables, "tables", "t", nil, "comma-separated list of static tables to render: all, "+tableNames)
+	flags.StringVarP(&h.options.Dashboard, "dynamic", "d", "", "dynamic dashboard to render: node, validator, rpc, gas-price, epochs-history, active-validators, fleet")
+	flags.StringVar(&h.options.Host, "host", "", "address of the host to render the dynamic dashboard for")
+	flags.BoolVar(&h.options.Subscribe, "subscribe", false, "sample the counters of the node and rpc dynamic dashboards on the events of the host received over WebSocket, polling is used as the fallback")
+	flags.StringVarP(&h.options.Output, "output", "o", "", "output format for static tables: table, json, csv, markdown, html")
+	flags.StringVar(&h.options.OutFile, "out-file", "", "file to write the static tables output to instead of stdout")
+
+	cmd.MarkFlagsMutuallyExclusive("static", "dynamic")
+	cmd.MarkFlagsMutuallyExclusive("tables", "dynamic")
+	cmd.MarkFlagsMutuallyExclusive("static", "subscribe")
+	cmd.MarkFlagsMutuallyExclusive("tables", "subscribe")
+
 	return cmd
 }
 
 func (h *MonitorHandler) handleCommand(_ *cobra.Command, _ []string) {
-	if err := h.controller.Monitor(); err != nil {
+	if err := h.controller.Monitor(h.options); err != nil {
 		fmt.Printf("Failed to run! %s\n", err)
 	}
 }
@@ -0,0 +1,72 @@
+package cmdhandlers
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/domain/enums"
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+// currentEpoch is the to epoch value which compares the validators set with the current one.
+const currentEpoch = -1
+
+type ValidatorsDiffHandler struct {
+	command    *cobra.Command
+	controller ports.ValidatorsController
+	options    ports.ValidatorsDiffOptions
+}
+
+func NewValidatorsDiffHandler(
+	controller ports.ValidatorsController,
+) *ValidatorsDiffHandler {
+	handler := &ValidatorsDiffHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ValidatorsDiffHandler) Start() {
+	_ = h.command.Execute()
+}
+
+func (h *ValidatorsDiffHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ValidatorsDiffHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ValidatorsDiffHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "diff",
+		Aliases: []string{"d"},
+		Short:   "Print the changes of the active validators set between two epochs.",
+		Long:    "The suimon validators diff subcommand compares the active validators sets of two epochs, requested with the suix_getEpochs method of the public extended RPC, and prints the validators which joined or were removed from the set and the changes of the voting power, stake, gas price and commission rate of the validators. The changes are grouped by their type and sorted by their magnitude. The validators set of the from epoch is compared with the current one if the to epoch is not provided.",
+		Example: "  suimon validators diff --network mainnet --from-epoch 100 --to-epoch 110\n  suimon validators diff --network mainnet --from-epoch 100 --output json",
+		Run:     h.handleCommand,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&h.options.Network, "network", "n", "", "network configuration to use, e.g. testnet for the suimon-testnet.yaml file")
+	flags.IntVarP(&h.options.FromEpoch, "from-epoch", "f", 0, "epoch to compare the validators set from")
+	flags.IntVarP(&h.options.ToEpoch, "to-epoch", "t", currentEpoch, "epoch to compare the validators set to, defaults to the current epoch")
+	flags.StringVarP(&h.options.Output, "output", "o", enums.OutputFormatTable.ToString(), "output format: table, json, csv, markdown, html")
+
+	_ = cmd.MarkFlagRequired("from-epoch")
+
+	return cmd
+}
+
+func (h *ValidatorsDiffHandler) handleCommand(_ *cobra.Command, _ []string) {
+	if err := h.controller.ValidatorsDiff(h.options); err != nil {
+		fmt.Printf("Failed to run! %s\n", err)
+	}
+}
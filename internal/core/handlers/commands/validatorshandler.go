@@ -0,0 +1,42 @@
+package cmdhandlers
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+type ValidatorsHandler struct {
+	command *cobra.Command
+}
+
+func NewValidatorsHandler() *ValidatorsHandler {
+	handler := &ValidatorsHandler{}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *ValidatorsHandler) Start() {
+	_ = h.command.Execute()
+}
+
+func (h *ValidatorsHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *ValidatorsHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *ValidatorsHandler) newCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "validators",
+		Aliases: []string{"vs"},
+		Short:   "Inspect the active validators set of the network.",
+		Long:    "The suimon validators subcommand groups the commands inspecting the active validators set of the network beyond the snapshot shown in the ACTIVE VALIDATORS table.",
+	}
+}
@@ -0,0 +1,67 @@
+package cmdhandlers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bartosian/suimon/internal/core/ports"
+)
+
+const watchIntervalDefault = 30 * time.Second
+
+type WatchHandler struct {
+	command    *cobra.Command
+	controller ports.WatchController
+	options    ports.WatchOptions
+}
+
+func NewWatchHandler(
+	controller ports.WatchController,
+) *WatchHandler {
+	handler := &WatchHandler{
+		controller: controller,
+	}
+
+	handler.command = handler.newCommand()
+
+	return handler
+}
+
+func (h *WatchHandler) Start() {
+	_ = h.command.Execute()
+}
+
+func (h *WatchHandler) AddSubCommands(subcommands ...ports.Command) {
+	for _, subcommand := range subcommands {
+		h.command.AddCommand(subcommand.Command())
+	}
+}
+
+func (h *WatchHandler) Command() *cobra.Command {
+	return h.command
+}
+
+func (h *WatchHandler) newCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "watch",
+		Aliases: []string{"w"},
+		Short:   "Poll the hosts and report the alerts defined in the alerts section of the configuration.",
+		Long:    "The suimon watch subcommand polls the hosts from the selected configuration on an interval and evaluates the alert rules defined in the alerts section of the configuration against them. Rules can be built on the host health status, e.g. status == red, or on the metric thresholds, e.g. checkpoint-sync-backlog > 500 for 2m. Every alert is reported once when it fires, repeated on the configured repeat interval while it keeps firing and reported once when it resolves. The validators tracked by their sui-address or name are alerted on when they get reported, cross the slashing percentage threshold or are at risk. The summary of every ended epoch is reported as well.",
+		Example: "  suimon watch --network mainnet --interval 30s",
+		Run:     h.handleCommand,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&h.options.Network, "network", "n", "", "network configuration to use, e.g. testnet for the suimon-testnet.yaml file")
+	flags.DurationVarP(&h.options.Interval, "interval", "i", watchIntervalDefault, "interval between the hosts polls")
+
+	return cmd
+}
+
+func (h *WatchHandler) handleCommand(_ *cobra.Command, _ []string) {
+	if err := h.controller.Watch(h.options); err != nil {
+		fmt.Printf("Failed to run! %s\n", err)
+	}
+}
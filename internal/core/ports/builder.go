@@ -1,6 +1,25 @@
 package ports
 
+import "github.com/bartosian/suimon/internal/core/domain/enums"
+
 type Builder interface {
 	Init() error
 	Render() error
 }
+
+type StaticBuilder interface {
+	Builder
+	Records() TableRecords
+}
+
+type (
+	// TableRecord represents a single table row as raw values keyed by the column names.
+	TableRecord map[enums.ColumnName]any
+
+	// TableRecords represents the rows of a table as structured records.
+	TableRecords struct {
+		Table   enums.TableType
+		Columns []enums.ColumnName
+		Rows    []TableRecord
+	}
+)
@@ -1,5 +1,7 @@
 package ports
 
+import "time"
+
 type RootController interface {
 	BeforeStart() bool
 }
@@ -9,7 +11,85 @@ type VersionController interface {
 }
 
 type MonitorController interface {
-	Monitor() error
+	Monitor(options MonitorOptions) error
 	Static() error
 	Dynamic() error
 }
+
+// MonitorOptions holds the monitor selections provided on the command line.
+// Empty values mean the selection has to be prompted from the user.
+type MonitorOptions struct {
+	Network   string
+	Static    bool
+	Tables    []string
+	Dashboard string
+	Host      string
+	Subscribe bool
+	Output    string
+	OutFile   string
+}
+
+type ExporterController interface {
+	Export(options ExporterOptions) error
+}
+
+// ExporterOptions holds the exporter settings provided on the command line.
+type ExporterOptions struct {
+	Network  string
+	Listen   string
+	Interval time.Duration
+}
+
+type WatchController interface {
+	Watch(options WatchOptions) error
+}
+
+// WatchOptions holds the watch settings provided on the command line.
+type WatchOptions struct {
+	Network  string
+	Interval time.Duration
+}
+
+type HistoryController interface {
+	History(options HistoryOptions) error
+}
+
+// HistoryOptions holds the history query provided on the command line.
+type HistoryOptions struct {
+	Network string
+	Table   string
+	Host    string
+	Metric  string
+	Since   time.Duration
+	Output  string
+}
+
+type ValidatorsController interface {
+	ValidatorsDiff(options ValidatorsDiffOptions) error
+}
+
+// ValidatorsDiffOptions holds the epochs to compare the validators sets of provided on the command line.
+// Negative to epoch means the validators set of the from epoch is compared with the current one.
+type ValidatorsDiffOptions struct {
+	Network   string
+	FromEpoch int
+	ToEpoch   int
+	Output    string
+}
+
+type ConfigController interface {
+	Init(options ConfigInitOptions) error
+	Lint(options ConfigLintOptions) error
+}
+
+// ConfigInitOptions holds the config init settings provided on the command line.
+// Empty network means the network has to be prompted from the user.
+type ConfigInitOptions struct {
+	Network string
+}
+
+// ConfigLintOptions holds the configuration files to lint provided on the command line.
+// All files in the configuration directory are linted if no files are provided.
+type ConfigLintOptions struct {
+	Files []string
+}
@@ -1,7 +1,10 @@
 package ports
 
 import (
+	"context"
+	"encoding/json"
 	"net"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -10,16 +13,93 @@ import (
 
 type RPCGateway interface {
 	CallFor(method enums.RPCMethod, params ...interface{}) (result any, err error)
+	BatchCallFor(requests ...RPCRequest) (responses []RPCResponse, err error)
+	FailedAttempts() int
 }
 
 type PrometheusGateway interface {
 	CallFor(metrics Metrics) (result MetricsResult, err error)
+	FailedAttempts() int
+}
+
+// SubscriptionGateway delivers the notifications of the RPC subscriptions. Subscribe blocks until
+// the context is done or the subscription is dropped, the error is returned in the latter case.
+type SubscriptionGateway interface {
+	Subscribe(ctx context.Context, method enums.RPCMethod, params []any, notify func(notification json.RawMessage)) error
 }
 
 type GeoGateway interface {
 	CallFor(ip net.IP) (result *IPResult, err error)
 }
 
+type NotifierGateway interface {
+	Notify(notification Notification) error
+}
+
+type HistoryGateway interface {
+	Record(network string, records []HistoryRecord) error
+	Query(query HistoryQuery) ([]HistoryRecord, error)
+}
+
+type (
+	// RPCRequest holds a single RPC method call sent in a batch request.
+	RPCRequest struct {
+		Method enums.RPCMethod
+		Params []any
+	}
+
+	// RPCResponse holds the result or the error of a single RPC method call sent in a batch request.
+	RPCResponse struct {
+		Result any
+		Err    error
+	}
+)
+
+type (
+	// HistoryRecord holds the data of a single host recorded on a single poll.
+	// Values holds the numeric metrics keyed by the metric aliases, it is empty if the host failed to respond.
+	// Host is the address of the host along with its port, Key identifies the host the records are kept by,
+	// so the records of the hosts sharing an address do not overwrite each other.
+	HistoryRecord struct {
+		Table     string             `json:"table"`
+		Host      string             `json:"host"`
+		Key       string             `json:"-"`
+		Timestamp time.Time          `json:"timestamp"`
+		Status    string             `json:"status"`
+		Reasons   []string           `json:"reasons,omitempty"`
+		Values    map[string]float64 `json:"values,omitempty"`
+	}
+
+	// HistoryQuery holds the filters of the recorded data, empty table and host match all tables and hosts.
+	// The host matches the address of the host along with its port, or all hosts on the address without the port.
+	HistoryQuery struct {
+		Network string
+		Table   string
+		Host    string
+		Since   time.Time
+	}
+)
+
+type (
+	// Notification holds the alert event delivered by the notifiers. Value is the current value of the failing metric
+	// and Reference is the value of the same metric reported by the RPC, if any.
+	Notification struct {
+		State     string    `json:"state"`
+		Repeated  bool      `json:"repeated"`
+		Rule      string    `json:"rule"`
+		Condition string    `json:"condition"`
+		Network   string    `json:"network"`
+		Table     string    `json:"table"`
+		Host      string    `json:"host"`
+		Metric    string    `json:"metric"`
+		Value     string    `json:"value"`
+		Reference string    `json:"reference,omitempty"`
+		Since     time.Time `json:"since"`
+		At        time.Time `json:"at"`
+		Message   string    `json:"message"`
+	}
+)
+
 type (
 	MetricResult struct {
 		Value  float64
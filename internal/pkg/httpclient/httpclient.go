@@ -0,0 +1,58 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+// Options holds the settings of the HTTP client used to request the monitored endpoints.
+type Options struct {
+	Timeout time.Duration
+	Headers http.Header
+	TLS     *tls.Config
+}
+
+// headerTransport sets the headers on every request sent through the underlying transport,
+// keeping the headers already set on the request.
+type headerTransport struct {
+	headers   http.Header
+	transport http.RoundTripper
+}
+
+// New creates the HTTP client with the timeout, the headers and the TLS settings from the options.
+func New(options Options) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if options.TLS != nil {
+		transport.TLSClientConfig = options.TLS
+	}
+
+	client := &http.Client{
+		Timeout:   options.Timeout,
+		Transport: transport,
+	}
+
+	if len(options.Headers) > 0 {
+		client.Transport = &headerTransport{headers: options.Headers, transport: transport}
+	}
+
+	return client
+}
+
+// RoundTrip sets the headers on the copy of the request and sends it through the underlying transport.
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+
+	for name, values := range t.headers {
+		if req.Header.Get(name) != "" {
+			continue
+		}
+
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+
+	return t.transport.RoundTrip(req)
+}
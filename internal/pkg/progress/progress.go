@@ -27,6 +27,7 @@ func NewProgressBar(action string, color Color) chan<- struct{} {
 		progressbar.OptionSetElapsedTime(false),
 		progressbar.OptionShowBytes(false),
 		progressbar.OptionClearOnFinish(),
+		progressbar.OptionSetWriter(os.Stderr),
 		progressbar.OptionSetWidth(30),
 		progressbar.OptionSetDescription(fmt.Sprintf("%s [ %s... ] [reset]", color, action)),
 		progressbar.OptionSetTheme(progressbar.Theme{
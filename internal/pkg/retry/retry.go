@@ -0,0 +1,81 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"syscall"
+	"time"
+)
+
+// maxDelay caps the delay between the attempts, so a large number of retries does not stall the caller.
+const maxDelay = 30 * time.Second
+
+// Policy holds the number of times a failed call is retried and the base delay before the first retry.
+type Policy struct {
+	Retries int
+	Backoff time.Duration
+}
+
+// Do calls fn until it succeeds, fails with an error which is not transient or the retries are exhausted.
+// The delay before every next retry is doubled and jittered, the retries stop once the context is done.
+// It returns the number of failed attempts along with the error of the last attempt.
+func Do(ctx context.Context, policy Policy, isTransient func(error) bool, fn func() error) (failed int, err error) {
+	for attempt := 0; ; attempt++ {
+		if err = fn(); err == nil {
+			return failed, nil
+		}
+
+		failed++
+
+		if attempt >= policy.Retries || !isTransient(err) {
+			return failed, err
+		}
+
+		timer := time.NewTimer(Delay(policy.Backoff, attempt))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return failed, err
+		case <-timer.C:
+		}
+	}
+}
+
+// Delay returns the delay before the retry following the specified attempt, counted from zero.
+// The delay is picked randomly between the half and the whole of the exponential delay, so the
+// retries of the calls failed at the same time are spread out.
+func Delay(backoff time.Duration, attempt int) time.Duration {
+	if backoff <= 0 {
+		return 0
+	}
+
+	delay := maxDelay
+	if attempt < 32 && backoff<<attempt > 0 && backoff<<attempt < maxDelay {
+		delay = backoff << attempt
+	}
+
+	half := delay / 2
+
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// IsTransient reports whether the error is a network error the call is worth retrying on,
+// which is a timeout, a connection reset or a connection closed in the middle of the response.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
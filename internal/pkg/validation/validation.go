@@ -20,7 +20,7 @@ func IsValidPort(port string) bool {
 		return false
 	}
 
-	return portInt >= 1 || portInt <= 65535
+	return portInt >= 1 && portInt <= 65535
 }
 
 func IsInvalidPort(port string) bool {
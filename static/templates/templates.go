@@ -0,0 +1,29 @@
+// Package templates bundles the configuration file templates of the Sui networks into the binary.
+package templates
+
+import (
+	"embed"
+	"fmt"
+)
+
+var (
+	//go:embed suimon-mainnet.yaml suimon-testnet.yaml suimon-devnet.yaml
+	files embed.FS
+
+	networks = []string{"mainnet", "testnet", "devnet"}
+)
+
+// Networks returns the names of the networks the templates are bundled for.
+func Networks() []string {
+	return networks
+}
+
+// Read returns the configuration file template of the specified network.
+func Read(network string) ([]byte, error) {
+	data, err := files.ReadFile(fmt.Sprintf("suimon-%s.yaml", network))
+	if err != nil {
+		return nil, fmt.Errorf("no configuration template found for network %q", network)
+	}
+
+	return data, nil
+}